@@ -0,0 +1,28 @@
+package main
+
+import (
+	"github.com/typescript-eslint/tsgolint/internal/baseline"
+)
+
+// used by --baseline-write and --baseline-prune when --baseline isn't passed
+const defaultBaselineFileName = "tsgolint-baseline.json"
+
+func writeBaseline(path string, b *baseline.Baseline) error {
+	data, err := b.Marshal()
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, string(data))
+}
+
+func countBaseline(b *baseline.Baseline) int {
+	count := 0
+	for _, rules := range b.Files {
+		for _, messages := range rules {
+			for _, n := range messages {
+				count += n
+			}
+		}
+	}
+	return count
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime/debug"
+
+	"github.com/typescript-eslint/tsgolint/internal/cache"
+	"github.com/typescript-eslint/tsgolint/internal/linter"
+)
+
+// used by --cache when --cache-location isn't passed
+const defaultCacheFileName = ".tsgolintcache"
+
+// linterVersion identifies the build of tsgolint, since rules and their
+// defaults change between builds. Builds without a module version, like
+// local ones, are told apart by their executable.
+func linterVersion() string {
+	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "" && info.Main.Version != "(devel)" {
+		return info.Main.Version
+	}
+	executable, err := os.Executable()
+	if err != nil {
+		return ""
+	}
+	stat, err := os.Stat(executable)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%v %v", stat.Size(), stat.ModTime().UnixNano())
+}
+
+// cacheSalt is the part of the cache keys of a project that doesn't come from
+// its files: the linter, its configuration and the compiler options.
+func cacheSalt(version string, lintConfigText string, options linter.Options, p *project) (string, error) {
+	compilerOptions, err := json.Marshal(p.config.CompilerOptions())
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%v\x00%v\x00%v\x00%v\x00%s", version, lintConfigText, options.ReportUnusedDirectives, options.TypeCheck, compilerOptions), nil
+}
+
+func writeCache(path string, c *cache.Cache) error {
+	data, err := c.Marshal()
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, string(data))
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/microsoft/typescript-go/shim/vfs"
+	"github.com/typescript-eslint/tsgolint/internal/ignore"
+)
+
+// stringList is a flag that can be passed multiple times.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+// lintTarget is a file or directory passed on the command line.
+type lintTarget struct {
+	arg         string
+	fileName    string
+	exists      bool
+	isDirectory bool
+	// whether a file of the program is the target or in it
+	matched bool
+	// whether an ignore pattern excludes the target file
+	ignored bool
+	// with --workspace, the nearest tsconfig, relative to the working directory
+	tsconfig   string
+	noTSConfig bool
+}
+
+type lintTargets []*lintTarget
+
+func newLintTargets(args []string, workingDirectory string, fs vfs.FS) lintTargets {
+	targets := make(lintTargets, len(args))
+	for i, arg := range args {
+		fileName := tspath.ResolvePath(workingDirectory, arg)
+		isDirectory := fs.DirectoryExists(fileName)
+		targets[i] = &lintTarget{
+			arg:         arg,
+			fileName:    fileName,
+			exists:      isDirectory || fs.FileExists(fileName),
+			isDirectory: isDirectory,
+		}
+	}
+	return targets
+}
+
+// match reports whether a program file was asked for and marks the targets
+// it belongs to. Dependencies in node_modules are only linted when they are
+// named on their own.
+func (targets lintTargets) match(fileName string, ignored bool, comparePathOptions tspath.ComparePathsOptions) bool {
+	matched := false
+	for _, target := range targets {
+		if target.isDirectory {
+			if !tspath.ContainsPath(target.fileName, fileName, comparePathOptions) || strings.Contains(fileName, "/node_modules/") {
+				continue
+			}
+		} else if tspath.ComparePaths(target.fileName, fileName, comparePathOptions) != 0 {
+			continue
+		}
+		target.matched = true
+		target.ignored = ignored && !target.isDirectory
+		matched = true
+	}
+	return matched
+}
+
+// printWarnings explains why targets that didn't make it into the linted
+// files were skipped.
+func (targets lintTargets) printWarnings(w io.Writer, tsconfigs string) {
+	for _, target := range targets {
+		tsconfig := tsconfigs
+		if target.tsconfig != "" {
+			tsconfig = target.tsconfig
+		}
+		switch {
+		case !target.exists:
+			fmt.Fprintf(w, "warning: %v doesn't exist\n", target.arg)
+		case target.noTSConfig:
+			fmt.Fprintf(w, "warning: no tsconfig found for %v, so it isn't linted\n", target.arg)
+		case !target.matched && target.isDirectory:
+			fmt.Fprintf(w, "warning: no files of the program of %v are in %v\n", tsconfig, target.arg)
+		case !target.matched:
+			fmt.Fprintf(w, "warning: %v isn't part of the program of %v, so it isn't linted\n", target.arg, tsconfig)
+		case target.ignored:
+			fmt.Fprintf(w, "warning: %v is ignored by an ignore pattern\n", target.arg)
+		}
+	}
+}
+
+// newIgnoreMatchers reads .tsgolintignore from the tsconfig directory and
+// adds the --ignore-pattern patterns, which are relative to where tsgolint
+// runs, like the files and directories.
+func newIgnoreMatchers(fs vfs.FS, currentDirectory string, workingDirectory string, patterns []string) (ignoreMatcher *ignore.Matcher, ignorePatternMatcher *ignore.Matcher, err error) {
+	ignoreMatcher = ignore.NewMatcher(currentDirectory, fs.UseCaseSensitiveFileNames())
+	if text, ok := fs.ReadFile(tspath.CombinePaths(currentDirectory, ignore.FileName)); ok {
+		if err := ignoreMatcher.AddFile(text); err != nil {
+			return nil, nil, fmt.Errorf("error in %v: %w", ignore.FileName, err)
+		}
+	}
+	ignorePatternMatcher = ignore.NewMatcher(workingDirectory, fs.UseCaseSensitiveFileNames())
+	for _, pattern := range patterns {
+		if err := ignorePatternMatcher.Add(pattern); err != nil {
+			return nil, nil, fmt.Errorf("error in --ignore-pattern: %w", err)
+		}
+	}
+	return ignoreMatcher, ignorePatternMatcher, nil
+}
+
+// fileSelector chooses which files of the programs are linted.
+type fileSelector struct {
+	fs vfs.FS
+	// all files of the projects' directories are linted if empty
+	targets lintTargets
+	// .tsgolintignore
+	ignoreMatcher *ignore.Matcher
+	// --ignore-pattern
+	ignorePatternMatcher *ignore.Matcher
+	comparePathOptions   tspath.ComparePathsOptions
+	workspace            bool
+}
+
+func (s *fileSelector) selectFiles(ps *projects) {
+	ps.resetFiles()
+	if s.workspace {
+		ps.useNearestTSConfigs(s.fs)
+	}
+	for _, p := range ps.list {
+		if p.program == nil {
+			continue
+		}
+		projectPath := string(tspath.ToPath("", p.directory(), s.fs.UseCaseSensitiveFileNames()).EnsureTrailingDirectorySeparator())
+		for _, file := range p.program.SourceFiles() {
+			// files are only matched with the project that can lint them, so
+			// that targets outside of its program are warned about
+			if !ps.canLint(p, file) {
+				continue
+			}
+			path := string(file.Path())
+			ignored := s.ignoreMatcher.Ignores(file.FileName()) || s.ignorePatternMatcher.Ignores(file.FileName())
+			// the whole program is loaded for type information, but only the
+			// asked for files are linted
+			if len(s.targets) > 0 {
+				if !s.targets.match(file.FileName(), ignored, s.comparePathOptions) {
+					continue
+				}
+			} else if strings.Contains(path, "/node_modules/") || !strings.HasPrefix(path, projectPath) {
+				continue
+			}
+			if ignored || !ps.claim(p, file) {
+				continue
+			}
+			p.files = append(p.files, file)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/ignore"
+	"gotest.tools/v3/assert"
+)
+
+var ignoreFiles = map[string]string{
+	"tsconfig.json":               `{"include": ["src"]}`,
+	"src/a.ts":                    "import { g } from './generated/g';\nexport const a = g;\n",
+	"src/a.test.ts":               "export const test = 1;\n",
+	"src/generated/g.ts":          "export const g = 1;\n",
+	"src/lib/b.ts":                "import { x } from 'x';\nexport const b = x;\n",
+	"node_modules/x/index.d.ts":   "export declare const x: number;\n",
+	"node_modules/x/package.json": `{"name": "x", "types": "index.d.ts"}`,
+	"other/c.ts":                  "export const c = 1;\n",
+}
+
+// selectIgnoring selects the files linted for args with the .tsgolintignore
+// text and --ignore-pattern patterns, and returns them, relative to the
+// project directory, along with the warnings about args.
+func selectIgnoring(t *testing.T, args []string, ignoreFile string, ignorePatterns ...string) ([]string, string) {
+	t.Helper()
+	return selectIgnoringIn(t, ".", args, ignoreFile, ignorePatterns...)
+}
+
+// selectIgnoringIn is selectIgnoring with tsgolint running in workingDirectory,
+// relative to the project directory.
+func selectIgnoringIn(t *testing.T, workingDirectory string, args []string, ignoreFile string, ignorePatterns ...string) ([]string, string) {
+	t.Helper()
+
+	directory := writeFiles(t, ignoreFiles)
+	if ignoreFile != "" {
+		assert.NilError(t, os.WriteFile(tspath.CombinePaths(directory, ignore.FileName), []byte(ignoreFile), 0o644))
+	}
+	workingDirectory = tspath.ResolvePath(directory, workingDirectory)
+	fs := newTestFS()
+	ps, err := loadProjects(true, fs, []string{tspath.CombinePaths(directory, "tsconfig.json")}, nil)
+	assert.NilError(t, err)
+
+	selector := newTestSelector(fs, workingDirectory, newLintTargets(args, workingDirectory, fs), false)
+	selector.ignoreMatcher, selector.ignorePatternMatcher, err = newIgnoreMatchers(fs, directory, workingDirectory, ignorePatterns)
+	assert.NilError(t, err)
+	selector.selectFiles(ps)
+
+	var linted []string
+	for fileName := range lintedFiles(ps, directory) {
+		linted = append(linted, fileName)
+	}
+	slices.Sort(linted)
+	var warnings strings.Builder
+	selector.targets.printWarnings(&warnings, "tsconfig.json")
+	return linted, warnings.String()
+}
+
+func TestSelectFilesOfProjectDirectory(t *testing.T) {
+	linted, warnings := selectIgnoring(t, nil, "")
+	// dependencies in node_modules are part of the program, but aren't linted
+	assert.DeepEqual(t, linted, []string{"src/a.test.ts", "src/a.ts", "src/generated/g.ts", "src/lib/b.ts"})
+	assert.Equal(t, warnings, "")
+}
+
+func TestSelectFilesIgnoresPatterns(t *testing.T) {
+	linted, warnings := selectIgnoring(t, nil, "# generated by the build\nsrc/generated/\n", "*.test.ts")
+	assert.DeepEqual(t, linted, []string{"src/a.ts", "src/lib/b.ts"})
+	assert.Equal(t, warnings, "")
+}
+
+func TestSelectFilesOfTargets(t *testing.T) {
+	linted, warnings := selectIgnoring(t, []string{"src/lib", "src/a.ts", "node_modules/x/index.d.ts"}, "")
+	// named files in node_modules are linted
+	assert.DeepEqual(t, linted, []string{"node_modules/x/index.d.ts", "src/a.ts", "src/lib/b.ts"})
+	assert.Equal(t, warnings, "")
+}
+
+func TestSelectFilesWarnsAboutTargets(t *testing.T) {
+	linted, warnings := selectIgnoring(t, []string{"src", "src/generated/g.ts", "other", "other/c.ts", "missing.ts"}, "src/generated/\n")
+	assert.DeepEqual(t, linted, []string{"src/a.test.ts", "src/a.ts", "src/lib/b.ts"})
+	// ignored files in directories are skipped quietly, only named ones are
+	// warned about
+	assert.Equal(t, warnings, strings.Join([]string{
+		"warning: src/generated/g.ts is ignored by an ignore pattern",
+		"warning: no files of the program of tsconfig.json are in other",
+		"warning: other/c.ts isn't part of the program of tsconfig.json, so it isn't linted",
+		"warning: missing.ts doesn't exist",
+		"",
+	}, "\n"))
+}
+
+func TestSelectFilesIgnoresPatternsRelativeToWorkingDirectory(t *testing.T) {
+	// .tsgolintignore is relative to the tsconfig, --ignore-pattern to where
+	// tsgolint runs
+	linted, _ := selectIgnoringIn(t, "src", nil, "src/generated/\n", "lib/b.ts")
+	assert.DeepEqual(t, linted, []string{"src/a.test.ts", "src/a.ts"})
+}
+
+func TestNewIgnoreMatchersErrors(t *testing.T) {
+	fs := newTestFS()
+	directory := writeFiles(t, map[string]string{ignore.FileName: "src/[\n"})
+	_, _, err := newIgnoreMatchers(fs, directory, directory, nil)
+	assert.ErrorContains(t, err, "error in .tsgolintignore: ")
+
+	_, _, err = newIgnoreMatchers(fs, t.TempDir(), directory, []string{"src/["})
+	assert.ErrorContains(t, err, "error in --ignore-pattern: ")
+}
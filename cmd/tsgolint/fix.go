@@ -0,0 +1,141 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/diff"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+)
+
+const (
+	fixDryRunFormatDiff = "diff"
+	fixDryRunFormatJSON = "json"
+)
+
+type suggestionSelector struct {
+	ruleName  string
+	messageId string
+}
+
+// suggestionSelectors is a flag.Value for --apply-suggestions, which can be
+// repeated and takes a comma-separated list of rule:messageId pairs.
+type suggestionSelectors []suggestionSelector
+
+func (s *suggestionSelectors) String() string {
+	selectors := make([]string, len(*s))
+	for i, selector := range *s {
+		selectors[i] = selector.ruleName + ":" + selector.messageId
+	}
+	return strings.Join(selectors, ",")
+}
+
+func (s *suggestionSelectors) Set(value string) error {
+	for selector := range strings.SplitSeq(value, ",") {
+		ruleName, messageId, ok := strings.Cut(strings.TrimSpace(selector), ":")
+		if !ok || ruleName == "" || messageId == "" {
+			return fmt.Errorf("expected rule:messageId, got %q", selector)
+		}
+		r, ok := registry.Get(ruleName)
+		if !ok {
+			return fmt.Errorf("unknown rule %q", ruleName)
+		}
+		if !r.Meta.HasSuggestions {
+			return fmt.Errorf("rule %q doesn't provide suggestions", ruleName)
+		}
+		*s = append(*s, suggestionSelector{ruleName, messageId})
+	}
+	return nil
+}
+
+// selectFixes picks what to apply for each diagnostic: its fixes if applyFixes
+// is set, otherwise the first of its suggestions matched by suggestions.
+func selectFixes(applyFixes bool, suggestions suggestionSelectors) func(d rule.RuleDiagnostic) []rule.RuleFix {
+	return func(d rule.RuleDiagnostic) []rule.RuleFix {
+		if applyFixes && len(d.Fixes()) > 0 {
+			return d.Fixes()
+		}
+		if d.Suggestions == nil {
+			return nil
+		}
+		for _, suggestion := range *d.Suggestions {
+			for _, selector := range suggestions {
+				if selector.ruleName == d.RuleName && selector.messageId == suggestion.Message.Id {
+					return suggestion.Fixes()
+				}
+			}
+		}
+		return nil
+	}
+}
+
+type fileEdits struct {
+	File  string      `json:"file"`
+	Edits []diff.Edit `json:"edits"`
+}
+
+// printPendingFixes prints what --fix would change, either as a unified diff
+// that can be applied with `git apply` or as a JSON list of edits per file.
+func printPendingFixes(w io.Writer, format string, fileNames []string, originalTexts map[string]string, fixedTexts map[string]string, comparePathOptions tspath.ComparePathsOptions) error {
+	if format == fixDryRunFormatJSON {
+		files := make([]fileEdits, 0, len(fileNames))
+		for _, fileName := range fileNames {
+			files = append(files, fileEdits{
+				File:  tspath.ConvertToRelativePath(fileName, comparePathOptions),
+				Edits: diff.Edits(originalTexts[fileName], fixedTexts[fileName]),
+			})
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		encoder.SetEscapeHTML(false)
+		return encoder.Encode(files)
+	}
+
+	for _, fileName := range fileNames {
+		relativeFileName := tspath.ConvertToRelativePath(fileName, comparePathOptions)
+		if _, err := fmt.Fprint(w, diff.Unified("a/"+relativeFileName, "b/"+relativeFileName, originalTexts[fileName], fixedTexts[fileName])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeFileAtomic replaces the file at path, so that an interrupted run never
+// leaves a half-written source file behind. The file mode is preserved.
+func writeFileAtomic(path string, text string) error {
+	perm := os.FileMode(0o644)
+	if info, err := os.Stat(path); err == nil {
+		perm = info.Mode().Perm()
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tsgolint-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.WriteString(text); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
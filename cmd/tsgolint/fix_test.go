@@ -0,0 +1,60 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"gotest.tools/v3/assert"
+)
+
+func TestSuggestionSelectorsSet(t *testing.T) {
+	var selectors suggestionSelectors
+	assert.NilError(t, selectors.Set("no-floating-promises:floatingFixVoid, return-await:wrapInAwait"))
+	assert.NilError(t, selectors.Set("no-floating-promises:floatingFixAwait"))
+	assert.Equal(t, len(selectors), 3)
+	assert.Equal(t, selectors.String(), "no-floating-promises:floatingFixVoid,return-await:wrapInAwait,no-floating-promises:floatingFixAwait")
+
+	for value, message := range map[string]string{
+		"no-floating-promises":     `expected rule:messageId, got "no-floating-promises"`,
+		"no-floating-promises:":    `expected rule:messageId, got "no-floating-promises:"`,
+		"no-such-rule:fix":         `unknown rule "no-such-rule"`,
+		"no-base-to-string:anyFix": `rule "no-base-to-string" doesn't provide suggestions`,
+	} {
+		var selectors suggestionSelectors
+		assert.Error(t, selectors.Set(value), message)
+	}
+}
+
+func fixTexts(fixes []rule.RuleFix) []string {
+	texts := make([]string, len(fixes))
+	for i, fix := range fixes {
+		texts[i] = fix.Text
+	}
+	return texts
+}
+
+func TestSelectFixes(t *testing.T) {
+	fix := rule.RuleFix{Text: "fix", Range: core.NewTextRange(0, 1)}
+	voidFix := rule.RuleFix{Text: "void ", Range: core.NewTextRange(0, 0)}
+	awaitFix := rule.RuleFix{Text: "await ", Range: core.NewTextRange(0, 0)}
+	suggestions := []rule.RuleSuggestion{
+		{Message: rule.RuleMessage{Id: "floatingFixVoid"}, FixesArr: []rule.RuleFix{voidFix}},
+		{Message: rule.RuleMessage{Id: "floatingFixAwait"}, FixesArr: []rule.RuleFix{awaitFix}},
+	}
+	withSuggestions := rule.RuleDiagnostic{RuleName: "no-floating-promises", Suggestions: &suggestions}
+	withFixes := rule.RuleDiagnostic{RuleName: "no-floating-promises", FixesPtr: &[]rule.RuleFix{fix}, Suggestions: &suggestions}
+
+	awaitSelectors := suggestionSelectors{{"return-await", "floatingFixVoid"}, {"no-floating-promises", "floatingFixAwait"}}
+	assert.DeepEqual(t, fixTexts(selectFixes(false, awaitSelectors)(withSuggestions)), []string{awaitFix.Text})
+	// the first matching suggestion of the diagnostic wins, not the first selector
+	bothSelectors := suggestionSelectors{{"no-floating-promises", "floatingFixAwait"}, {"no-floating-promises", "floatingFixVoid"}}
+	assert.DeepEqual(t, fixTexts(selectFixes(false, bothSelectors)(withSuggestions)), []string{voidFix.Text})
+	// selectors only match suggestions of their rule
+	assert.Assert(t, selectFixes(false, suggestionSelectors{{"return-await", "floatingFixVoid"}})(withSuggestions) == nil)
+
+	// fixes win over suggestions with --fix, and are ignored without it
+	assert.DeepEqual(t, fixTexts(selectFixes(true, awaitSelectors)(withFixes)), []string{fix.Text})
+	assert.DeepEqual(t, fixTexts(selectFixes(false, awaitSelectors)(withFixes)), []string{awaitFix.Text})
+	assert.Assert(t, selectFixes(true, nil)(withSuggestions) == nil)
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/formatter"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+)
+
+const (
+	formatPretty     = "pretty"
+	formatCompact    = "compact"
+	formatJSON       = "json"
+	formatSARIF      = "sarif"
+	formatGitHub     = "github"
+	formatGitLab     = "gitlab"
+	formatCheckstyle = "checkstyle"
+	formatJUnit      = "junit"
+)
+
+var formats = []string{formatPretty, formatCompact, formatJSON, formatSARIF, formatGitHub, formatGitLab, formatCheckstyle, formatJUnit}
+
+// newFormatter returns nil for the pretty format, which is printed while linting.
+func newFormatter(format string, w io.Writer, rules []rule.Rule, comparePathOptions tspath.ComparePathsOptions) formatter.Formatter {
+	switch format {
+	case formatCompact:
+		return formatter.NewCompactFormatter(w, comparePathOptions)
+	case formatJSON:
+		return formatter.NewJSONFormatter(w, comparePathOptions)
+	case formatSARIF:
+		return formatter.NewSARIFFormatter(w, rules, comparePathOptions)
+	case formatGitHub:
+		return formatter.NewGitHubFormatter(w, comparePathOptions)
+	case formatGitLab:
+		return formatter.NewGitLabFormatter(w, comparePathOptions)
+	case formatCheckstyle:
+		return formatter.NewCheckstyleFormatter(w, comparePathOptions)
+	case formatJUnit:
+		return formatter.NewJUnitFormatter(w, comparePathOptions)
+	default:
+		return nil
+	}
+}
+
+// createOutputFile creates the file for --output-file, along with its directory,
+// which usually doesn't exist yet in fresh CI checkouts.
+func createOutputFile(path string) (*os.File, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	return os.Create(path)
+}
+
+// useColors tells whether the pretty output can use colors and box drawing.
+// Following https://no-color.org, NO_COLOR turns them off, as does piping the
+// output into a file or another program.
+func useColors(noColor bool, out *os.File) bool {
+	if noColor || os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	info, err := out.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+var ansiEscapeRegexp = regexp.MustCompile("\x1b\\[[0-9;:]*m")
+
+// printSummary prints a line of the pretty output, dropping its escape codes
+// when colors are off.
+func printSummary(w io.Writer, colors bool, format string, args ...any) {
+	line := fmt.Sprintf(format, args...)
+	if !colors {
+		line = ansiEscapeRegexp.ReplaceAllString(line, "")
+	}
+	io.WriteString(w, line)
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/microsoft/typescript-go/shim/vfs"
+)
+
+func runGit(directory string, args ...string) ([]byte, error) {
+	cmd := exec.Command("git", append([]string{"-C", directory}, args...)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, fmt.Errorf("git %v: %v", strings.Join(args, " "), message)
+		}
+		return nil, fmt.Errorf("git %v: %w", strings.Join(args, " "), err)
+	}
+	return out, nil
+}
+
+func gitRoot(directory string) (string, error) {
+	out, err := runGit(directory, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", err
+	}
+	return tspath.NormalizePath(strings.TrimSpace(string(out))), nil
+}
+
+// gitChangedFiles asks git for the files that were added, copied, modified
+// or renamed, either in the index or since a revision. Changes since a
+// revision include uncommitted and untracked files, so that a hook sees the
+// same files as the person running it. The returned names are absolute.
+func gitChangedFiles(directory string, changedSince string, staged bool) ([]string, error) {
+	if strings.HasPrefix(changedSince, "-") {
+		return nil, fmt.Errorf("invalid revision %q", changedSince)
+	}
+
+	root, err := gitRoot(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	var outputs [][]byte
+	if staged {
+		out, err := runGit(directory, "diff", "--cached", "--name-only", "-z", "--diff-filter=ACMR")
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, out)
+	} else {
+		out, err := runGit(directory, "diff", "--name-only", "-z", "--diff-filter=ACMR", changedSince, "--")
+		if err != nil {
+			return nil, err
+		}
+		untracked, err := runGit(directory, "ls-files", "--others", "--exclude-standard", "--full-name", "-z")
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, out, untracked)
+	}
+
+	var fileNames []string
+	for _, out := range outputs {
+		for name := range strings.SplitSeq(string(out), "\x00") {
+			if name != "" {
+				fileNames = append(fileNames, tspath.ResolvePath(root, name))
+			}
+		}
+	}
+	return fileNames, nil
+}
+
+// gitStagedTexts reads the staged text of files with unstaged changes, by
+// their absolute names, so that they are linted as they will be committed.
+// Files whose text in the working tree is the staged one are left out.
+func gitStagedTexts(directory string, fileNames []string, fs vfs.FS) (map[string]string, error) {
+	root, err := gitRoot(directory)
+	if err != nil {
+		return nil, err
+	}
+	comparePathOptions := tspath.ComparePathsOptions{
+		CurrentDirectory:          root,
+		UseCaseSensitiveFileNames: fs.UseCaseSensitiveFileNames(),
+	}
+
+	texts := map[string]string{}
+	for _, fileName := range fileNames {
+		// :path is relative to the root of the repository
+		out, err := runGit(root, "show", ":"+tspath.ConvertToRelativePath(fileName, comparePathOptions))
+		if err != nil {
+			return nil, err
+		}
+		if text, ok := fs.ReadFile(fileName); !ok || text != string(out) {
+			texts[fileName] = string(out)
+		}
+	}
+	return texts, nil
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"gotest.tools/v3/assert"
+)
+
+func git(t *testing.T, directory string, args ...string) {
+	t.Helper()
+
+	cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+	cmd.Dir = directory
+	out, err := cmd.CombinedOutput()
+	assert.NilError(t, err, string(out))
+}
+
+func TestGitStagedTexts(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	directory := writeFiles(t, map[string]string{
+		"src/partly.ts": "export const a = 1;\n",
+		"src/fully.ts":  "export const b = 1;\n",
+	})
+	git(t, directory, "init", "-q")
+	git(t, directory, "add", ".")
+	git(t, directory, "commit", "-q", "-m", "initial")
+
+	partly := tspath.CombinePaths(directory, "src/partly.ts")
+	fully := tspath.CombinePaths(directory, "src/fully.ts")
+	assert.NilError(t, os.WriteFile(partly, []byte("export const a = 2;\n"), 0o644))
+	assert.NilError(t, os.WriteFile(fully, []byte("export const b = 2;\n"), 0o644))
+	git(t, directory, "add", ".")
+	assert.NilError(t, os.WriteFile(partly, []byte("export const a = 3;\n"), 0o644))
+
+	fileNames, err := gitChangedFiles(tspath.CombinePaths(directory, "src"), "", true)
+	assert.NilError(t, err)
+	assert.Equal(t, len(fileNames), 2)
+
+	texts, err := gitStagedTexts(tspath.CombinePaths(directory, "src"), fileNames, newTestFS())
+	assert.NilError(t, err)
+	assert.DeepEqual(t, texts, map[string]string{partly: "export const a = 2;\n"})
+}
@@ -2,10 +2,13 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"flag"
 	"fmt"
+	"maps"
 	"math"
 	"os"
+	"os/signal"
 	"runtime"
 	"runtime/pprof"
 	"runtime/trace"
@@ -13,56 +16,24 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"text/tabwriter"
 	"time"
 	"unicode"
+	"unicode/utf8"
 
+	"github.com/typescript-eslint/tsgolint/internal/baseline"
+	"github.com/typescript-eslint/tsgolint/internal/cache"
+	"github.com/typescript-eslint/tsgolint/internal/config"
+	"github.com/typescript-eslint/tsgolint/internal/formatter"
 	"github.com/typescript-eslint/tsgolint/internal/linter"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 
-	"github.com/typescript-eslint/tsgolint/internal/rules/await_thenable"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_array_delete"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_base_to_string"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_confusing_void_expression"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_duplicate_type_constituents"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_floating_promises"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_for_in_array"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_implied_eval"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_meaningless_void_operator"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_misused_promises"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_misused_spread"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_mixed_enums"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_redundant_type_constituents"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_unnecessary_boolean_literal_compare"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_unnecessary_template_expression"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_unnecessary_type_arguments"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_unnecessary_type_assertion"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_unsafe_argument"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_unsafe_assignment"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_unsafe_call"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_unsafe_enum_comparison"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_unsafe_member_access"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_unsafe_return"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_unsafe_type_assertion"
-	"github.com/typescript-eslint/tsgolint/internal/rules/no_unsafe_unary_minus"
-	"github.com/typescript-eslint/tsgolint/internal/rules/non_nullable_type_assertion_style"
-	"github.com/typescript-eslint/tsgolint/internal/rules/only_throw_error"
-	"github.com/typescript-eslint/tsgolint/internal/rules/prefer_promise_reject_errors"
-	"github.com/typescript-eslint/tsgolint/internal/rules/prefer_reduce_type_parameter"
-	"github.com/typescript-eslint/tsgolint/internal/rules/prefer_return_this_type"
-	"github.com/typescript-eslint/tsgolint/internal/rules/promise_function_async"
-	"github.com/typescript-eslint/tsgolint/internal/rules/related_getter_setter_pairs"
-	"github.com/typescript-eslint/tsgolint/internal/rules/require_array_sort_compare"
-	"github.com/typescript-eslint/tsgolint/internal/rules/require_await"
-	"github.com/typescript-eslint/tsgolint/internal/rules/restrict_plus_operands"
-	"github.com/typescript-eslint/tsgolint/internal/rules/restrict_template_expressions"
-	"github.com/typescript-eslint/tsgolint/internal/rules/return_await"
-	"github.com/typescript-eslint/tsgolint/internal/rules/switch_exhaustiveness_check"
-	"github.com/typescript-eslint/tsgolint/internal/rules/unbound_method"
-	"github.com/typescript-eslint/tsgolint/internal/rules/use_unknown_in_catch_callback_variable"
-
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/bundled"
+	"github.com/microsoft/typescript-go/shim/compiler"
 	"github.com/microsoft/typescript-go/shim/scanner"
 	"github.com/microsoft/typescript-go/shim/tspath"
 	"github.com/microsoft/typescript-go/shim/vfs/cachedvfs"
@@ -71,7 +42,9 @@ import (
 
 const spaces = "                                                                                                    "
 
-func printDiagnostic(d rule.RuleDiagnostic, w *bufio.Writer, comparePathOptions tspath.ComparePathsOptions) {
+// printDiagnostic prints a code box with the diagnostic's range underlined. Without
+// colors, only ASCII is used and the range is marked with carets.
+func printDiagnostic(d rule.RuleDiagnostic, w *bufio.Writer, comparePathOptions tspath.ComparePathsOptions, colors bool) {
 	diagnosticStart := d.Range.Pos()
 	diagnosticEnd := d.Range.End()
 
@@ -93,28 +66,42 @@ func printDiagnostic(d rule.RuleDiagnostic, w *bufio.Writer, comparePathOptions
 	}
 	codeboxEnd := scanner.GetPositionOfLineAndCharacter(d.SourceFile, codeboxEndLine, codeboxEndColumn)
 
-	w.Write([]byte{' ', 0x1b, '[', '7', 'm', 0x1b, '[', '1', 'm', 0x1b, '[', '3', '8', ';', '5', ';', '3', '7', 'm', ' '})
-	w.WriteString(d.RuleName)
-	w.WriteString(" \x1b[0m — ")
+	// picks the colored or the plain ASCII form of a part of the output
+	style := func(colored string, plain string) string {
+		if colors {
+			return colored
+		}
+		return plain
+	}
+
+	// errors are highlighted in red, warnings in yellow
+	severityColor := "160"
+	ruleNameColor := "37"
+	if d.Severity == rule.SeverityWarning {
+		severityColor = "178"
+		ruleNameColor = "178"
+	}
+
+	w.WriteString(style(" \x1b[7m\x1b[1m\x1b[38;5;"+ruleNameColor+"m "+d.RuleName+" \x1b[0m — ", " "+d.RuleName+" ("+d.Severity.String()+") — "))
 	messageLineStart := 0
 	for i, char := range d.Message.Description {
 		if char == '\n' {
 			w.WriteString(d.Message.Description[messageLineStart : i+1])
 			messageLineStart = i + 1
-			w.WriteString("    \x1b[2m│\x1b[0m")
+			w.WriteString(style("    \x1b[2m│\x1b[0m", "    |"))
 			w.WriteString(spaces[:len(d.RuleName)+1])
 		}
 	}
 	if messageLineStart <= len(d.Message.Description) {
 		w.WriteString(d.Message.Description[messageLineStart:len(d.Message.Description)])
 	}
-	w.WriteString("\n  \x1b[2m╭─┴──────────(\x1b[0m \x1b[3m\x1b[38;5;117m")
+	w.WriteString(style("\n  \x1b[2m╭─┴──────────(\x1b[0m \x1b[3m\x1b[38;5;117m", "\n  --> "))
 	w.WriteString(tspath.ConvertToRelativePath(d.SourceFile.FileName(), comparePathOptions))
 	w.WriteByte(':')
 	w.Write([]byte(strconv.Itoa(diagnosticStartLine + 1)))
 	w.WriteByte(':')
 	w.Write([]byte(strconv.Itoa(diagnosticStartColumn + 1)))
-	w.WriteString("\x1b[0m \x1b[2m)─────\x1b[0m\n")
+	w.WriteString(style("\x1b[0m \x1b[2m)─────\x1b[0m\n", "\n"))
 
 	indentSize := math.MaxInt
 	line := codeboxStartLine
@@ -125,7 +112,7 @@ func printDiagnostic(d rule.RuleDiagnostic, w *bufio.Writer, comparePathOptions
 	lineEnds := make([]int, 13)
 
 	if codeboxEndLine-codeboxStartLine >= len(lineEnds) {
-		w.WriteString("  \x1b[2m│\x1b[0m  Error range is too big. Skipping code block printing.\n  \x1b[2m╰────────────────────────────────\x1b[0m\n\n")
+		w.WriteString(style("  \x1b[2m│\x1b[0m  Error range is too big. Skipping code block printing.\n  \x1b[2m╰────────────────────────────────\x1b[0m\n\n", "  |  Error range is too big. Skipping code block printing.\n\n"))
 		return
 	}
 
@@ -157,7 +144,7 @@ func printDiagnostic(d rule.RuleDiagnostic, w *bufio.Writer, comparePathOptions
 	diagnosticHighlightActive := false
 	lastLineNumber := strconv.Itoa(codeboxEndLine + 1)
 	for line := codeboxStartLine; line <= codeboxEndLine; line++ {
-		w.WriteString("  \x1b[2m│ ")
+		w.WriteString(style("  \x1b[2m│ ", "  "))
 		if line == codeboxEndLine {
 			w.WriteString(lastLineNumber)
 		} else {
@@ -167,7 +154,7 @@ func printDiagnostic(d rule.RuleDiagnostic, w *bufio.Writer, comparePathOptions
 			}
 			w.WriteString(number)
 		}
-		w.Write([]byte(" │\x1b[0m  "))
+		w.WriteString(style(" │\x1b[0m  ", " |  "))
 
 		lineTextStart := int(lineMap[line]) + indentSize
 		underlineStart := max(lineTextStart, int(lineMap[line])+lineStarts[line-codeboxStartLine])
@@ -186,15 +173,16 @@ func printDiagnostic(d rule.RuleDiagnostic, w *bufio.Writer, comparePathOptions
 			diagnosticHighlightActive = false
 		}
 
-		if underlineStart != underlineEnd {
+		if underlineStart != underlineEnd && !colors {
+			w.WriteString(text[lineTextStart:lineTextEnd])
+			w.WriteString("\n  ")
+			w.WriteString(strings.Repeat(" ", len(lastLineNumber)))
+			w.WriteString(" |  ")
+			w.WriteString(caretIndent(text[lineTextStart:underlineStart]))
+			w.WriteString(strings.Repeat("^", utf8.RuneCountInString(text[underlineStart:underlineEnd])))
+		} else if underlineStart != underlineEnd {
 			w.WriteString(text[lineTextStart:underlineStart])
-			w.Write([]byte{
-				0x1b, '[', '4', 'm',
-				0x1b, '[', '4', ':', '3', 'm',
-				0x1b, '[', '5', '8', ':', '5', ':', '1', '6', '0', 'm',
-				0x1b, '[', '3', '8', ';', '5', ';', '1', '6', '0', 'm',
-				0x1b, '[', '2', '2', ';', '4', '9', 'm',
-			})
+			w.WriteString("\x1b[4m\x1b[4:3m\x1b[58:5:" + severityColor + "m\x1b[38;5;" + severityColor + "m\x1b[22;49m")
 			w.WriteString(text[underlineStart:underlineEnd])
 			w.Write([]byte{0x1b, '[', '0', 'm'})
 			w.WriteString(text[underlineEnd:lineTextEnd])
@@ -204,17 +192,96 @@ func printDiagnostic(d rule.RuleDiagnostic, w *bufio.Writer, comparePathOptions
 
 		w.WriteByte('\n')
 	}
-	w.WriteString("  \x1b[2m╰────────────────────────────────\x1b[0m\n\n")
+	w.WriteString(style("  \x1b[2m╰────────────────────────────────\x1b[0m\n\n", "\n"))
+}
+
+// caretIndent returns the blank space that puts carets under the end of
+// lineText. Tabs are kept, so that they are as wide as in the line above.
+func caretIndent(lineText string) string {
+	var indent strings.Builder
+	for _, char := range lineText {
+		if char == '\t' {
+			indent.WriteByte('\t')
+		} else {
+			indent.WriteByte(' ')
+		}
+	}
+	return indent.String()
+}
+
+func printRules(w *bufio.Writer) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tCATEGORY\tFIXABLE\tSUGGESTIONS\tOPTIONS\tDOCS")
+	yesNo := func(b bool) string {
+		if b {
+			return "yes"
+		}
+		return "-"
+	}
+	for _, r := range registry.All() {
+		fmt.Fprintf(tw, "%v\t%v\t%v\t%v\t%v\t%v\n", r.Name, r.Meta.Category, yesNo(r.Meta.Fixable), yesNo(r.Meta.HasSuggestions), yesNo(registry.AcceptsOptions(r)), r.Meta.DocsURL)
+	}
+	tw.Flush()
 }
 
 const usage = `✨ tsgolint - speedy TypeScript linter
 
 Usage:
-    tsgolint [OPTIONS]
+    tsgolint [OPTIONS] [FILE|DIR...]
+
+Lints the given files and directories, or all files of the TS programs in the directories of the tsconfigs.
 
 Options:
-    --tsconfig PATH   Which tsconfig to use. Defaults to tsconfig.json.
-		--list-files      List matched files
+    --tsconfig PATH   Which tsconfig to use. Defaults to tsconfig.json. Can be passed multiple times.
+                      Projects in "references" are linted too, each file with the program of its project.
+    --workspace       Lint each file in the given files and directories, or the current directory, with
+                      its nearest tsconfig.eslint.json or tsconfig.json
+    --config PATH     Which tsgolint config to use. Defaults to tsgolint.json next to the tsconfig.
+    --format FORMAT   Output format: pretty (default), compact, json, sarif, github, gitlab, checkstyle or junit
+    --output-file PATH
+                      Write the report to a file. Problems are still printed to the terminal.
+    --no-color        Print without colors and box drawing. This is the default when NO_COLOR is set
+                      or the output isn't a terminal.
+    --fix             Automatically fix problems and write the changes to disk
+    --fix-dry-run     Print the changes --fix would make as a unified diff without writing them.
+                      Exits with code 1 if there is anything to fix.
+    --fix-dry-run-format diff|json
+                      Print the changes as a unified diff (default) or as JSON edits per file
+    --apply-suggestions RULE:MESSAGE_ID[,...]
+                      Apply the suggestions with these message ids, as if they were fixes.
+                      Can be combined with --fix and --fix-dry-run.
+    --ignore-pattern PATTERN
+                      Don't lint files matching a .gitignore-style pattern. Can be passed multiple times.
+                      Patterns are also read from .tsgolintignore next to the tsconfig.
+    --changed-since REV
+                      Only lint files changed since a git revision, including uncommitted and untracked ones
+    --staged          Only lint files staged in git, e.g. in a pre-commit hook. Files are linted as they are
+                      staged, and fixes aren't written to files with unstaged changes.
+    --include-dependents
+                      With --changed-since or --staged, also lint files that import changed files
+    --stdin           Lint the text read from stdin as the file given by --stdin-filename.
+                      With --fix, the fixed text is written to stdout and problems to stderr.
+    --stdin-filename PATH
+                      Path of the file read from stdin, which needn't exist
+    --cache           Only lint files whose text, imports, config or tsgolint version changed since the last run
+    --cache-location PATH
+                      Where to store the cache. Defaults to .tsgolintcache
+    --watch           Lint again when files change, only the changed files and the files importing them
+    --list-files      List matched files
+    --list-rules      List available rules
+    --max-warnings N  Exit with an error if there are more than N warnings
+    --baseline PATH   Only report problems beyond the counts recorded in a baseline file
+    --baseline-write  Record all problems in the baseline file (--baseline, or tsgolint-baseline.json)
+    --baseline-prune  Remove the problems that no longer occur from the baseline file
+    --report-unused-disable-directives
+                      Report disable comments that suppress nothing or name unknown rules.
+                      --fix removes them.
+    --type-check      Also report TS type errors, as rules named after their code, like ts(2322)
+    --timing          Print the time spent in the slowest rules, program creation, binding and, with --type-check,
+                      type checking
+    --stats           Print the files that took the longest, with the time spent in rules and type checking,
+                      and the numbers of nodes and problems. --format json reports all files.
+    --stats-count N   How many files --stats prints. Defaults to 10.
     -h, --help        Show help
 `
 
@@ -222,17 +289,75 @@ func runMain() int {
 	flag.Usage = func() { fmt.Fprint(os.Stderr, usage) }
 
 	var (
-		help      bool
-		tsconfig  string
-		listFiles bool
+		help             bool
+		tsconfigs        stringList
+		workspace        bool
+		configPath       string
+		listFiles        bool
+		listRules        bool
+		maxWarnings      int
+		format           string
+		outputFile       string
+		noColor          bool
+		fix              bool
+		fixDryRun        bool
+		fixDryRunFormat  string
+		applySuggestions suggestionSelectors
+
+		reportUnusedDisableDirectives bool
+		typeCheck                     bool
+		ignorePatterns                stringList
+		changedSince                  string
+		staged                        bool
+		includeDependents             bool
+		stdin                         bool
+		stdinFilename                 string
+		watch                         bool
+		useCache                      bool
+		cacheLocation                 string
+		showTiming                    bool
+		showStats                     bool
+		statsCount                    int
+
+		baselinePath  string
+		baselineWrite bool
+		baselinePrune bool
 
 		traceOut       string
 		cpuprofOut     string
 		singleThreaded bool
 	)
 
-	flag.StringVar(&tsconfig, "tsconfig", "", "which tsconfig to use")
+	flag.Var(&tsconfigs, "tsconfig", "which tsconfig to use")
+	flag.BoolVar(&workspace, "workspace", false, "use the nearest tsconfig of each file")
+	flag.StringVar(&configPath, "config", "", "which tsgolint config to use")
 	flag.BoolVar(&listFiles, "list-files", false, "list matched files")
+	flag.Var(&ignorePatterns, "ignore-pattern", "pattern of files not to lint")
+	flag.StringVar(&changedSince, "changed-since", "", "only lint files changed since a git revision")
+	flag.BoolVar(&staged, "staged", false, "only lint files staged in git")
+	flag.BoolVar(&includeDependents, "include-dependents", false, "also lint files importing changed files")
+	flag.BoolVar(&stdin, "stdin", false, "lint text from stdin")
+	flag.StringVar(&stdinFilename, "stdin-filename", "", "path of the file read from stdin")
+	flag.BoolVar(&watch, "watch", false, "lint again when files change")
+	flag.BoolVar(&useCache, "cache", false, "only lint files that changed since the last run")
+	flag.StringVar(&cacheLocation, "cache-location", "", "where to store the cache")
+	flag.BoolVar(&listRules, "list-rules", false, "list available rules")
+	flag.StringVar(&format, "format", formatPretty, "output format")
+	flag.StringVar(&outputFile, "output-file", "", "file to write the report to")
+	flag.BoolVar(&noColor, "no-color", false, "disable colors")
+	flag.BoolVar(&fix, "fix", false, "automatically fix problems")
+	flag.BoolVar(&fixDryRun, "fix-dry-run", false, "print fixes without writing them")
+	flag.StringVar(&fixDryRunFormat, "fix-dry-run-format", fixDryRunFormatDiff, "format of printed fixes")
+	flag.Var(&applySuggestions, "apply-suggestions", "apply suggestions by rule:messageId")
+	flag.IntVar(&maxWarnings, "max-warnings", -1, "number of warnings to trigger a non-zero exit code")
+	flag.StringVar(&baselinePath, "baseline", "", "only report problems beyond the counts in this baseline file")
+	flag.BoolVar(&baselineWrite, "baseline-write", false, "write all problems to the baseline file")
+	flag.BoolVar(&baselinePrune, "baseline-prune", false, "remove problems that no longer occur from the baseline file")
+	flag.BoolVar(&reportUnusedDisableDirectives, "report-unused-disable-directives", false, "report directive comments that suppress nothing")
+	flag.BoolVar(&typeCheck, "type-check", false, "also report TS type errors")
+	flag.BoolVar(&showTiming, "timing", false, "print the time spent in each rule")
+	flag.BoolVar(&showStats, "stats", false, "print the files that took the longest")
+	flag.IntVar(&statsCount, "stats-count", defaultStatsCount, "how many files --stats prints")
 	flag.BoolVar(&help, "help", false, "show help")
 	flag.BoolVar(&help, "h", false, "show help")
 
@@ -246,8 +371,98 @@ func runMain() int {
 		flag.Usage()
 		return 0
 	}
+	if !slices.Contains(formats, format) {
+		fmt.Fprintf(os.Stderr, "error: unknown format %q, expected one of %v\n", format, strings.Join(formats, ", "))
+		return 1
+	}
+	if outputFile != "" && format == formatPretty {
+		fmt.Fprintf(os.Stderr, "error: --output-file needs a --format other than %v\n", formatPretty)
+		return 1
+	}
+	if fixDryRunFormat != fixDryRunFormatDiff && fixDryRunFormat != fixDryRunFormatJSON {
+		fmt.Fprintf(os.Stderr, "error: unknown fix dry run format %q, expected %q or %q\n", fixDryRunFormat, fixDryRunFormatDiff, fixDryRunFormatJSON)
+		return 1
+	}
+	if fix && fixDryRun {
+		fmt.Fprintf(os.Stderr, "error: --fix and --fix-dry-run can't be used together\n")
+		return 1
+	}
+	// stdout is for the fixes, so that they can be piped into `git apply`
+	if fixDryRun && format != formatPretty && outputFile == "" {
+		fmt.Fprintf(os.Stderr, "error: --fix-dry-run prints the fixes to stdout, so --format %v needs --output-file\n", format)
+		return 1
+	}
+	if workspace && len(tsconfigs) > 0 {
+		fmt.Fprintf(os.Stderr, "error: --workspace and --tsconfig can't be used together\n")
+		return 1
+	}
+	if changedSince != "" && staged {
+		fmt.Fprintf(os.Stderr, "error: --changed-since and --staged can't be used together\n")
+		return 1
+	}
+	if includeDependents && changedSince == "" && !staged {
+		fmt.Fprintf(os.Stderr, "error: --include-dependents needs --changed-since or --staged\n")
+		return 1
+	}
+	if stdin && stdinFilename == "" {
+		fmt.Fprintf(os.Stderr, "error: --stdin needs --stdin-filename\n")
+		return 1
+	}
+	if stdinFilename != "" && !stdin {
+		fmt.Fprintf(os.Stderr, "error: --stdin-filename needs --stdin\n")
+		return 1
+	}
+	if stdin && (len(flag.Args()) > 0 || changedSince != "" || staged) {
+		fmt.Fprintf(os.Stderr, "error: --stdin lints only the text from stdin, so files can't be chosen\n")
+		return 1
+	}
+	// in watch mode problems are redrawn in the terminal after every change
+	if watch && (fix || fixDryRun || len(applySuggestions) > 0 || format != formatPretty || outputFile != "") {
+		fmt.Fprintf(os.Stderr, "error: --watch can't be used with fixes or other formats than %v\n", formatPretty)
+		return 1
+	}
+	if watch && (stdin || changedSince != "" || staged || baselineWrite || baselinePrune || useCache || showTiming || showStats) {
+		fmt.Fprintf(os.Stderr, "error: --watch can't be used with --stdin, --changed-since, --staged, --cache, --timing, --stats or writing the baseline\n")
+		return 1
+	}
+	if statsCount < 0 {
+		fmt.Fprintf(os.Stderr, "error: --stats-count must not be negative\n")
+		return 1
+	}
+	if cacheLocation != "" && !useCache {
+		fmt.Fprintf(os.Stderr, "error: --cache-location needs --cache\n")
+		return 1
+	}
+	if baselineWrite && baselinePrune {
+		fmt.Fprintf(os.Stderr, "error: --baseline-write and --baseline-prune can't be used together\n")
+		return 1
+	}
+	// the baseline would lose the problems of files that aren't linted
+	if (baselineWrite || baselinePrune) && (len(flag.Args()) > 0 || stdin || changedSince != "" || staged) {
+		fmt.Fprintf(os.Stderr, "error: the baseline can only be written when all files are linted\n")
+		return 1
+	}
+	// a dry run doesn't report the problems it leaves, so they can't be counted
+	if (baselineWrite || baselinePrune) && fixDryRun {
+		fmt.Fprintf(os.Stderr, "error: the baseline can't be written with --fix-dry-run\n")
+		return 1
+	}
+	if listRules {
+		w := bufio.NewWriter(os.Stdout)
+		printRules(w)
+		w.Flush()
+		return 0
+	}
 
-	enableVirtualTerminalProcessing()
+	// with --stdin --fix, stdout is only for the fixed text
+	stdout := os.Stdout
+	if stdin && fix {
+		stdout = os.Stderr
+	}
+	colors := useColors(noColor, stdout)
+	if colors {
+		enableVirtualTerminalProcessing()
+	}
 	timeBefore := time.Now()
 
 	if traceOut != "" {
@@ -282,66 +497,107 @@ func runMain() int {
 	}
 	currentDirectory = tspath.NormalizePath(currentDirectory)
 
-	fs := bundled.WrapFS(cachedvfs.From(osvfs.FS()))
-	var configFileName string
-	if tsconfig == "" {
-		configFileName = tspath.ResolvePath(currentDirectory, "tsconfig.json")
+	cachedFS := cachedvfs.From(osvfs.FS())
+	fs := bundled.WrapFS(cachedFS)
+	workingDirectory := currentDirectory
+	args := flag.Args()
+	var stdinInput stdinFile
+	if stdin {
+		fs, stdinInput, err = readStdin(os.Stdin, fs, workingDirectory, stdinFilename)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		args = []string{stdinFilename}
+	}
+	if workspace && len(args) == 0 {
+		args = []string{"."}
+	}
+	targets := newLintTargets(args, workingDirectory, fs)
+
+	var configFileNames []string
+	switch {
+	case workspace:
+		// files are linted with their nearest tsconfig, so that each gets a
+		// program with the compiler options it is built with
+		configFileNames = findWorkspaceTSConfigs(fs, targets, workingDirectory)
+		if len(configFileNames) == 0 {
+			targets.printWarnings(os.Stderr, "")
+			fmt.Fprintf(os.Stderr, "error: no files to lint\n")
+			return 1
+		}
+	case len(tsconfigs) == 0:
+		configFileName := tspath.ResolvePath(currentDirectory, "tsconfig.json")
 		if !fs.FileExists(configFileName) {
 			fs = utils.NewOverlayVFS(fs, map[string]string{
 				configFileName: "{}",
 			})
 		}
+		configFileNames = append(configFileNames, configFileName)
+	default:
+		for _, tsconfig := range tsconfigs {
+			configFileName := tspath.ResolvePath(currentDirectory, tsconfig)
+			if !fs.FileExists(configFileName) {
+				fmt.Fprintf(os.Stderr, "error: tsconfig %q doesn't exist\n", tsconfig)
+				return 1
+			}
+			configFileNames = append(configFileNames, configFileName)
+		}
+	}
+
+	// the first tsconfig decides where the tsgolint config is found and what
+	// paths in the output are relative to, except in a workspace, where the
+	// tsconfigs can be anywhere
+	if !workspace {
+		currentDirectory = tspath.GetDirectoryPath(configFileNames[0])
+	}
+
+	var lintConfigFileName string
+	if configPath == "" {
+		lintConfigFileName = config.FindConfigFile(fs, currentDirectory)
 	} else {
-		configFileName = tspath.ResolvePath(currentDirectory, tsconfig)
-		if !fs.FileExists(configFileName) {
-			fmt.Fprintf(os.Stderr, "error: tsconfig %q doesn't exist", tsconfig)
+		lintConfigFileName = tspath.ResolvePath(workingDirectory, configPath)
+		if !fs.FileExists(lintConfigFileName) {
+			fmt.Fprintf(os.Stderr, "error: config %q doesn't exist\n", configPath)
+			return 1
+		}
+	}
+	var lintConfig *config.Config
+	if lintConfigFileName != "" {
+		lintConfig, err = config.LoadConfigFile(fs, lintConfigFileName)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error loading config %v: %v\n", lintConfigFileName, err)
 			return 1
 		}
 	}
+	// overrides are relative to the config file
+	configDirectory := currentDirectory
+	if lintConfigFileName != "" {
+		configDirectory = tspath.GetDirectoryPath(lintConfigFileName)
+	}
+	ruleSet, err := config.NewRuleSet(lintConfig, registry.All(), configDirectory, fs.UseCaseSensitiveFileNames())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error in config %v:\n%v\n", lintConfigFileName, err)
+		return 1
+	}
 
-	currentDirectory = tspath.GetDirectoryPath(configFileName)
-
-	var rules = []rule.Rule{
-		await_thenable.AwaitThenableRule,
-		no_array_delete.NoArrayDeleteRule,
-		no_base_to_string.NoBaseToStringRule,
-		no_confusing_void_expression.NoConfusingVoidExpressionRule,
-		no_duplicate_type_constituents.NoDuplicateTypeConstituentsRule,
-		no_floating_promises.NoFloatingPromisesRule,
-		no_for_in_array.NoForInArrayRule,
-		no_implied_eval.NoImpliedEvalRule,
-		no_meaningless_void_operator.NoMeaninglessVoidOperatorRule,
-		no_misused_promises.NoMisusedPromisesRule,
-		no_misused_spread.NoMisusedSpreadRule,
-		no_mixed_enums.NoMixedEnumsRule,
-		no_redundant_type_constituents.NoRedundantTypeConstituentsRule,
-		no_unnecessary_boolean_literal_compare.NoUnnecessaryBooleanLiteralCompareRule,
-		no_unnecessary_template_expression.NoUnnecessaryTemplateExpressionRule,
-		no_unnecessary_type_arguments.NoUnnecessaryTypeArgumentsRule,
-		no_unnecessary_type_assertion.NoUnnecessaryTypeAssertionRule,
-		no_unsafe_argument.NoUnsafeArgumentRule,
-		no_unsafe_assignment.NoUnsafeAssignmentRule,
-		no_unsafe_call.NoUnsafeCallRule,
-		no_unsafe_enum_comparison.NoUnsafeEnumComparisonRule,
-		no_unsafe_member_access.NoUnsafeMemberAccessRule,
-		no_unsafe_return.NoUnsafeReturnRule,
-		no_unsafe_type_assertion.NoUnsafeTypeAssertionRule,
-		no_unsafe_unary_minus.NoUnsafeUnaryMinusRule,
-		non_nullable_type_assertion_style.NonNullableTypeAssertionStyleRule,
-		only_throw_error.OnlyThrowErrorRule,
-		prefer_promise_reject_errors.PreferPromiseRejectErrorsRule,
-		prefer_reduce_type_parameter.PreferReduceTypeParameterRule,
-		prefer_return_this_type.PreferReturnThisTypeRule,
-		promise_function_async.PromiseFunctionAsyncRule,
-		related_getter_setter_pairs.RelatedGetterSetterPairsRule,
-		require_array_sort_compare.RequireArraySortCompareRule,
-		require_await.RequireAwaitRule,
-		restrict_plus_operands.RestrictPlusOperandsRule,
-		restrict_template_expressions.RestrictTemplateExpressionsRule,
-		return_await.ReturnAwaitRule,
-		switch_exhaustiveness_check.SwitchExhaustivenessCheckRule,
-		unbound_method.UnboundMethodRule,
-		use_unknown_in_catch_callback_variable.UseUnknownInCatchCallbackVariableRule,
+	var changedFileNames []string
+	// files with unstaged changes, by their staged text
+	var stagedTexts map[string]string
+	if changedSince != "" || staged {
+		changedFileNames, err = gitChangedFiles(workingDirectory, changedSince, staged)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error getting changed files: %v\n", err)
+			return 1
+		}
+		if staged {
+			stagedTexts, err = gitStagedTexts(workingDirectory, changedFileNames, fs)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "error reading staged files: %v\n", err)
+				return 1
+			}
+			fs = utils.NewOverlayVFS(fs, stagedTexts)
+		}
 	}
 
 	host := utils.CreateCompilerHost(currentDirectory, fs)
@@ -351,119 +607,531 @@ func runMain() int {
 		UseCaseSensitiveFileNames: host.FS().UseCaseSensitiveFileNames(),
 	}
 
-	program, err := utils.CreateProgram(singleThreaded, fs, currentDirectory, configFileName, host)
+	var timing *linter.Timing
+	if showTiming {
+		timing = linter.NewTiming()
+	}
+	var stats *linter.Stats
+	if showStats {
+		stats = linter.NewStats()
+	}
+
+	loadedProjects, err := loadProjects(singleThreaded, fs, configFileNames, timing)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error creating TS program: %v\n", err)
+		return 1
+	}
+
+	ignoreMatcher, ignorePatternMatcher, err := newIgnoreMatchers(fs, currentDirectory, workingDirectory, ignorePatterns)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "error creating TS program: %v", err)
+		fmt.Fprintln(os.Stderr, err)
 		return 1
 	}
+	selector := &fileSelector{
+		fs:                   fs,
+		targets:              targets,
+		ignoreMatcher:        ignoreMatcher,
+		ignorePatternMatcher: ignorePatternMatcher,
+		comparePathOptions:   comparePathOptions,
+		workspace:            workspace,
+	}
+	selector.selectFiles(loadedProjects)
+	configNames := make([]string, len(configFileNames))
+	for i, configFileName := range configFileNames {
+		configNames[i] = tspath.ConvertToRelativePath(configFileName, tspath.ComparePathsOptions{
+			CurrentDirectory:          workingDirectory,
+			UseCaseSensitiveFileNames: fs.UseCaseSensitiveFileNames(),
+		})
+	}
+	targets.printWarnings(os.Stderr, strings.Join(configNames, ", "))
+
+	if changedSince != "" || staged {
+		for _, p := range loadedProjects.list {
+			if p.program == nil {
+				continue
+			}
+			changedFiles := []*ast.SourceFile{}
+			for _, fileName := range changedFileNames {
+				if file := p.program.GetSourceFile(fileName); file != nil {
+					changedFiles = append(changedFiles, file)
+				}
+			}
+			if includeDependents {
+				changedFiles = utils.GetDependents(p.program, changedFiles)
+			}
+			changed := make(map[*ast.SourceFile]struct{}, len(changedFiles))
+			for _, file := range changedFiles {
+				changed[file] = struct{}{}
+			}
+			p.files = slices.DeleteFunc(p.files, func(file *ast.SourceFile) bool {
+				_, ok := changed[file]
+				return !ok
+			})
+		}
+	}
 
-	files := []*ast.SourceFile{}
-	cwdPath := string(tspath.ToPath("", currentDirectory, program.Host().FS().UseCaseSensitiveFileNames()).EnsureTrailingDirectorySeparator())
+	filesCount := 0
 	var matchedFiles strings.Builder
-	for _, file := range program.SourceFiles() {
-		p := string(file.Path())
-		if strings.Contains(p, "/node_modules/") {
-			continue
+	for _, p := range loadedProjects.list {
+		filesCount += len(p.files)
+		for _, file := range p.files {
+			matchedFiles.WriteString("Found file: ")
+			matchedFiles.WriteString(tspath.ConvertToRelativePath(file.FileName(), comparePathOptions))
+			matchedFiles.WriteByte('\n')
 		}
-		if fileName, matched := strings.CutPrefix(p, cwdPath); matched {
-			if listFiles {
-				matchedFiles.WriteString("Found file: ")
-				matchedFiles.WriteString(fileName)
-				matchedFiles.WriteByte('\n')
+		slices.SortFunc(p.files, func(a *ast.SourceFile, b *ast.SourceFile) int {
+			return len(b.Text()) - len(a.Text())
+		})
+	}
+	if listFiles {
+		stdout.WriteString(matchedFiles.String())
+	}
+
+	rules := &fileRules{set: ruleSet}
+	linterOptions := linter.Options{
+		ReportUnusedDirectives: reportUnusedDisableDirectives,
+		IsKnownRule: func(name string) bool {
+			_, ok := registry.Get(name)
+			return ok
+		},
+		TypeCheck: typeCheck,
+		Timing:    timing,
+		Stats:     stats,
+	}
+
+	var baselineFileName string
+	var newBaselineTracker func() *baseline.Tracker
+	if baselinePath != "" || baselineWrite || baselinePrune {
+		if baselinePath == "" {
+			baselinePath = defaultBaselineFileName
+		}
+		baselineFileName = tspath.ResolvePath(workingDirectory, baselinePath)
+		var b *baseline.Baseline
+		if !baselineWrite {
+			b, err = baseline.Load(fs, baselineFileName)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "error loading baseline: %v\n", err)
+				return 1
 			}
-			files = append(files, file)
+		}
+		newBaselineTracker = func() *baseline.Tracker {
+			return baseline.NewTracker(b, tspath.GetDirectoryPath(baselineFileName), fs.UseCaseSensitiveFileNames())
 		}
 	}
-	if listFiles {
-		os.Stdout.WriteString(matchedFiles.String())
+
+	if watch {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		w := &watcher{
+			singleThreaded:     singleThreaded,
+			fs:                 fs,
+			cachedFS:           cachedFS,
+			configFileNames:    configFileNames,
+			selectFiles:        selector.selectFiles,
+			rules:              rules,
+			linterOptions:      linterOptions,
+			newBaselineTracker: newBaselineTracker,
+			rulesCount:         len(ruleSet.EnabledRuleNames()),
+			colors:             colors,
+			comparePathOptions: comparePathOptions,
+			projects:           loadedProjects,
+		}
+		return w.run(ctx, stdout)
+	}
+
+	var baselineTracker *baseline.Tracker
+	if newBaselineTracker != nil {
+		baselineTracker = newBaselineTracker()
 	}
-	slices.SortFunc(files, func(a *ast.SourceFile, b *ast.SourceFile) int {
-		return len(b.Text()) - len(a.Text())
-	})
 
 	var wg sync.WaitGroup
 
 	diagnosticsChan := make(chan rule.RuleDiagnostic, 4096)
 	errorsCount := 0
+	warningsCount := 0
+
+	w := bufio.NewWriterSize(stdout, 4096*100)
+	reportWriter := w
+	if outputFile != "" {
+		f, err := createOutputFile(tspath.ResolvePath(workingDirectory, outputFile))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error creating output file: %v\n", err)
+			return 1
+		}
+		defer f.Close()
+		reportWriter = bufio.NewWriter(f)
+	}
+	enabledRules := make([]rule.Rule, 0, len(ruleSet.EnabledRuleNames()))
+	for _, name := range ruleSet.EnabledRuleNames() {
+		if registered, ok := registry.Get(name); ok {
+			enabledRules = append(enabledRules, registered)
+		}
+	}
+	reportFormatter := newFormatter(format, reportWriter, enabledRules, comparePathOptions)
+	// other formats are meant for tools, so they only share the terminal with
+	// the pretty output when they are written to a file
+	printPretty := reportFormatter == nil || outputFile != ""
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		w := bufio.NewWriterSize(os.Stdout, 4096*100)
-		defer w.Flush()
-		for d := range diagnosticsChan {
-			errorsCount++
-			if errorsCount == 1 {
+		report := func(d rule.RuleDiagnostic) {
+			if d.Severity == rule.SeverityWarning {
+				warningsCount++
+			} else {
+				errorsCount++
+			}
+			if reportFormatter != nil {
+				reportFormatter.AddDiagnostic(d)
+			}
+			if !printPretty {
+				return
+			}
+			if errorsCount+warningsCount == 1 {
 				w.WriteByte('\n')
 			}
-			printDiagnostic(d, w, comparePathOptions)
+			printDiagnostic(d, w, comparePathOptions, colors)
 			if w.Available() < 4096 {
 				w.Flush()
 			}
 		}
+		if baselineTracker == nil {
+			for d := range diagnosticsChan {
+				report(d)
+			}
+			return
+		}
+
+		// which problems of a file are beyond the baseline depends on their
+		// order, so they are tracked once all of them are in
+		var diagnostics []rule.RuleDiagnostic
+		for d := range diagnosticsChan {
+			diagnostics = append(diagnostics, d)
+		}
+		newDiagnostics := baselineTracker.AddAll(diagnostics)
+		// everything goes into a baseline that is being written
+		if baselineWrite {
+			return
+		}
+		for _, d := range newDiagnostics {
+			report(d)
+		}
 	}()
 
-	err = linter.RunLinter(
-		program,
-		singleThreaded,
-		files,
-		func(sourceFile *ast.SourceFile) []linter.ConfiguredRule {
-			return utils.Map(rules, func(r rule.Rule) linter.ConfiguredRule {
-				return linter.ConfiguredRule{
-					Name: r.Name,
-					Run: func(ctx rule.RuleContext) rule.RuleListeners {
-						return r.Run(ctx, nil)
-					},
+	var lintCache *cache.Cache
+	var cacheFileName, cacheVersion, lintConfigText string
+	if useCache {
+		if cacheLocation == "" {
+			cacheLocation = defaultCacheFileName
+		}
+		cacheFileName = tspath.ResolvePath(workingDirectory, cacheLocation)
+		lintCache = cache.Load(fs, cacheFileName)
+		cacheVersion = linterVersion()
+		if lintConfigFileName != "" {
+			lintConfigText, _ = fs.ReadFile(lintConfigFileName)
+		}
+	}
+
+	fixing := fix || fixDryRun || len(applySuggestions) > 0
+	fixSelector := selectFixes(fix || fixDryRun, applySuggestions)
+	fixResult := linter.FixResult{FixedFiles: map[string]string{}}
+	originalTexts := map[string]string{}
+	for _, p := range loadedProjects.list {
+		if len(p.files) == 0 {
+			continue
+		}
+
+		files := p.files
+		var cacheKeys map[tspath.Path]string
+		if lintCache != nil {
+			var salt string
+			salt, err = cacheSalt(cacheVersion, lintConfigText, linterOptions, p)
+			if err != nil {
+				break
+			}
+			cacheKeys = cache.FileKeys(p.program, p.files, salt)
+			files = make([]*ast.SourceFile, 0, len(p.files))
+			for _, file := range p.files {
+				cached, ok := lintCache.Get(file, cacheKeys[file.Path()])
+				// fixes are applied by linting again
+				if !ok || fixing && slices.ContainsFunc(cached, func(d rule.RuleDiagnostic) bool {
+					return len(fixSelector(d)) > 0
+				}) {
+					files = append(files, file)
+					continue
 				}
-			})
-		},
-		func(d rule.RuleDiagnostic) {
-			diagnosticsChan <- d
-		},
-	)
+				if !fixDryRun {
+					for _, d := range cached {
+						diagnosticsChan <- d
+					}
+				}
+			}
+		}
+
+		var projectDiagnostics []rule.RuleDiagnostic
+		if fixing {
+			var projectResult linter.FixResult
+			projectResult, err = linter.RunLinterWithFixes(
+				p.program,
+				func(overlay map[string]string) (*compiler.Program, error) {
+					return p.createProgram(singleThreaded, utils.NewOverlayVFS(fs, overlay), timing)
+				},
+				singleThreaded,
+				files,
+				rules.get,
+				linterOptions,
+				fixSelector,
+			)
+			// every file belongs to one project, so the results don't overlap
+			maps.Copy(fixResult.FixedFiles, projectResult.FixedFiles)
+			fixResult.FixedCount += projectResult.FixedCount
+			for fileName := range projectResult.FixedFiles {
+				originalTexts[fileName] = p.program.GetSourceFile(fileName).Text()
+			}
+			projectDiagnostics = projectResult.Diagnostics
+			// a dry run prints only the fixes, so that they can be piped into `git apply`
+			if !fixDryRun {
+				for _, d := range projectResult.Diagnostics {
+					diagnosticsChan <- d
+				}
+			}
+		} else {
+			var projectDiagnosticsMu sync.Mutex
+			err = linter.RunLinter(
+				p.program,
+				singleThreaded,
+				files,
+				rules.get,
+				linterOptions,
+				func(d rule.RuleDiagnostic) {
+					if lintCache != nil {
+						projectDiagnosticsMu.Lock()
+						projectDiagnostics = append(projectDiagnostics, d)
+						projectDiagnosticsMu.Unlock()
+					}
+					diagnosticsChan <- d
+				},
+			)
+		}
+		if err == nil {
+			err = rules.takeErr()
+		}
+		if err != nil {
+			break
+		}
+
+		// a dry run lints against fixes that aren't written, so the files on
+		// disk don't match its results
+		if lintCache != nil && !fixDryRun {
+			diagnosticsByFile := map[string][]rule.RuleDiagnostic{}
+			for _, d := range projectDiagnostics {
+				diagnosticsByFile[d.SourceFile.FileName()] = append(diagnosticsByFile[d.SourceFile.FileName()], d)
+			}
+			// files linted against fixed text get keys that don't describe it
+			for _, file := range cache.UnaffectedFiles(p.program, files, slices.Collect(maps.Keys(fixResult.FixedFiles))) {
+				lintCache.Set(file.FileName(), cacheKeys[file.Path()], diagnosticsByFile[file.FileName()])
+			}
+		}
+		// a dry run prints only the fixes
+		if typeCheck && !fixDryRun {
+			start := time.Now()
+			globalDiagnostics := linter.GetGlobalDiagnostics(p.program, p.config.ConfigFile.SourceFile)
+			timing.AddPhase(linter.PhaseTypeChecking, time.Since(start))
+			for _, d := range globalDiagnostics {
+				diagnosticsChan <- d
+			}
+		}
+	}
 
 	close(diagnosticsChan)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "error running linter: %v\n", err)
 		return 1
 	}
+	if lintCache != nil && !fixDryRun {
+		lintCache.Prune(fs)
+		if err := writeCache(cacheFileName, lintCache); err != nil {
+			fmt.Fprintf(os.Stderr, "error writing cache: %v\n", err)
+			return 1
+		}
+	}
 
 	wg.Wait()
 
-	errorsColor := "\x1b[1m"
-	if errorsCount == 0 {
-		errorsColor = "\x1b[1;32m"
-	}
-	errorsText := "errors"
-	if errorsCount == 1 {
-		errorsText = "error"
-	}
-	filesText := "files"
-	if len(files) == 1 {
-		filesText = "file"
-	}
-	rulesText := "rules"
-	if len(rules) == 1 {
-		rulesText = "rule"
-	}
-	threadsCount := 1
-	if !singleThreaded {
-		threadsCount = runtime.GOMAXPROCS(0)
-	}
-	fmt.Fprintf(
-		os.Stdout,
-		"Found %v%v\x1b[0m %v \x1b[2m(linted \x1b[1m%v\x1b[22m\x1b[2m %v with \x1b[1m%v\x1b[22m\x1b[2m %v in \x1b[1m%v\x1b[22m\x1b[2m using \x1b[1m%v\x1b[22m\x1b[2m threads)\n",
-		errorsColor,
-		errorsCount,
-		errorsText,
-		len(files),
-		filesText,
-		len(rules),
-		rulesText,
-		time.Since(timeBefore).Round(time.Millisecond),
-		threadsCount,
-	)
+	if jsonFormatter, ok := reportFormatter.(*formatter.JSONFormatter); ok {
+		if timing != nil {
+			jsonFormatter.SetTiming(newTimingReport(timing, typeCheck))
+		}
+		if stats != nil {
+			jsonFormatter.SetStats(newStatsReport(stats, comparePathOptions))
+		}
+	}
+	if reportFormatter != nil {
+		err = reportFormatter.Finish()
+	}
+	if flushErr := reportWriter.Flush(); err == nil {
+		err = flushErr
+	}
+	if flushErr := w.Flush(); err == nil {
+		err = flushErr
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error writing report: %v\n", err)
+		return 1
+	}
+
+	fixedFileNames := make([]string, 0, len(fixResult.FixedFiles))
+	for fileName := range fixResult.FixedFiles {
+		fixedFileNames = append(fixedFileNames, fileName)
+	}
+	slices.Sort(fixedFileNames)
 
+	if fixDryRun {
+		if err := printPendingFixes(os.Stdout, fixDryRunFormat, fixedFileNames, originalTexts, fixResult.FixedFiles, comparePathOptions); err != nil {
+			fmt.Fprintf(os.Stderr, "error printing fixes: %v\n", err)
+			return 1
+		}
+		if len(fixedFileNames) > 0 {
+			problemsText := "problems"
+			if fixResult.FixedCount == 1 {
+				problemsText = "problem"
+			}
+			fixedFilesText := "files"
+			if len(fixedFileNames) == 1 {
+				fixedFilesText = "file"
+			}
+			if len(applySuggestions) > 0 {
+				fmt.Fprintf(os.Stderr, "%v %v in %v %v can be fixed automatically. Run again without --fix-dry-run to fix them.\n", fixResult.FixedCount, problemsText, len(fixedFileNames), fixedFilesText)
+			} else {
+				fmt.Fprintf(os.Stderr, "%v %v in %v %v can be fixed automatically. Run \"tsgolint --fix\" to fix them.\n", fixResult.FixedCount, problemsText, len(fixedFileNames), fixedFilesText)
+			}
+			return 1
+		}
+		return 0
+	}
+
+	if stdin && fix {
+		os.Stdout.WriteString(stdinInput.fixedText(fixResult.FixedFiles))
+	} else {
+		for _, fileName := range fixedFileNames {
+			// the fixes are made to the staged text, so writing them would
+			// lose the unstaged changes
+			if _, ok := stagedTexts[fileName]; ok {
+				fmt.Fprintf(os.Stderr, "warning: %v has unstaged changes, so its fixes aren't written\n", tspath.ConvertToRelativePath(fileName, comparePathOptions))
+				continue
+			}
+			if err := writeFileAtomic(fileName, fixResult.FixedFiles[fileName]); err != nil {
+				fmt.Fprintf(os.Stderr, "error writing fixes: %v\n", err)
+				return 1
+			}
+		}
+	}
+
+	if baselineWrite || baselinePrune {
+		b := baselineTracker.Current()
+		message := "Wrote %v %v to the baseline %v\n"
+		if baselinePrune {
+			b = baselineTracker.Pruned()
+			message = "Kept %v %v in the baseline %v\n"
+		}
+		if err := writeBaseline(baselineFileName, b); err != nil {
+			fmt.Fprintf(os.Stderr, "error writing baseline: %v\n", err)
+			return 1
+		}
+		problemsText := "problems"
+		if countBaseline(b) == 1 {
+			problemsText = "problem"
+		}
+		fmt.Fprintf(os.Stderr, message, countBaseline(b), problemsText, tspath.ConvertToRelativePath(baselineFileName, comparePathOptions))
+	}
+
+	if printPretty {
+		errorsColor := "\x1b[1m"
+		if errorsCount == 0 {
+			errorsColor = "\x1b[1;32m"
+		}
+		errorsText := "errors"
+		if errorsCount == 1 {
+			errorsText = "error"
+		}
+		warningsText := ""
+		if warningsCount == 1 {
+			warningsText = " and \x1b[1;33m1\x1b[0m warning"
+		} else if warningsCount > 1 {
+			warningsText = fmt.Sprintf(" and \x1b[1;33m%v\x1b[0m warnings", warningsCount)
+		}
+		filesText := "files"
+		if filesCount == 1 {
+			filesText = "file"
+		}
+		rulesText := "rules"
+		if len(enabledRules) == 1 {
+			rulesText = "rule"
+		}
+		threadsCount := 1
+		if !singleThreaded {
+			threadsCount = runtime.GOMAXPROCS(0)
+		}
+		printSummary(
+			stdout,
+			colors,
+			"Found %v%v\x1b[0m %v%v \x1b[2m(linted \x1b[1m%v\x1b[22m\x1b[2m %v with \x1b[1m%v\x1b[22m\x1b[2m %v in \x1b[1m%v\x1b[22m\x1b[2m using \x1b[1m%v\x1b[22m\x1b[2m threads)\n",
+			errorsColor,
+			errorsCount,
+			errorsText,
+			warningsText,
+			filesCount,
+			filesText,
+			len(enabledRules),
+			rulesText,
+			time.Since(timeBefore).Round(time.Millisecond),
+			threadsCount,
+		)
+		if fix || len(applySuggestions) > 0 {
+			problemsText := "problems"
+			if fixResult.FixedCount == 1 {
+				problemsText = "problem"
+			}
+			fixedFilesText := "files"
+			if len(fixedFileNames) == 1 {
+				fixedFilesText = "file"
+			}
+			printSummary(
+				stdout,
+				colors,
+				"Fixed \x1b[1;32m%v\x1b[0m %v in \x1b[1m%v\x1b[0m %v, \x1b[1m%v\x1b[0m remaining\n",
+				fixResult.FixedCount,
+				problemsText,
+				len(fixedFileNames),
+				fixedFilesText,
+				errorsCount+warningsCount,
+			)
+		}
+	}
+
+	// like the summary, these go to stderr when other formats are printed
+	statsOut := stdout
+	if !printPretty {
+		statsOut = os.Stderr
+	}
+	if timing != nil {
+		printTiming(statsOut, timing, typeCheck, colors)
+	}
+	if stats != nil {
+		printStats(statsOut, stats, statsCount, comparePathOptions, colors)
+	}
+
+	if errorsCount > 0 {
+		return 1
+	}
+	if maxWarnings >= 0 && warningsCount > maxWarnings {
+		messageOut := stdout
+		if !printPretty {
+			messageOut = os.Stderr
+		}
+		fmt.Fprintf(messageOut, "tsgolint found too many warnings (maximum: %v).\n", maxWarnings)
+		return 1
+	}
 	return 0
 }
 
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/rules/fixtures"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+	"gotest.tools/v3/assert"
+)
+
+func TestPrintDiagnosticWithTabs(t *testing.T) {
+	text := "function f(x: boolean) {\n\tif (x) {\n\t\tlet n = 1;\t// one\n\t}\n}\n"
+	rootDir := fixtures.GetRootDir()
+	fs := utils.NewOverlayVFSForFile(tspath.ResolvePath(rootDir, "file.ts"), text)
+	program, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.json", utils.CreateCompilerHost(rootDir, fs))
+	assert.NilError(t, err)
+
+	start := strings.Index(text, "1")
+	d := rule.RuleDiagnostic{
+		Range:      core.NewTextRange(start, start+1),
+		RuleName:   "numbers",
+		Message:    rule.RuleMessage{Id: "number", Description: "Unexpected number."},
+		SourceFile: program.GetSourceFile("file.ts"),
+	}
+	var out strings.Builder
+	w := bufio.NewWriter(&out)
+	printDiagnostic(d, w, tspath.ComparePathsOptions{CurrentDirectory: rootDir}, false)
+	assert.NilError(t, w.Flush())
+
+	// the common indent is left out, and the tab left of the carets is kept
+	assert.Equal(t, out.String(), strings.Join([]string{
+		" numbers (error) — Unexpected number.",
+		"  --> file.ts:3:11",
+		"  2 |  if (x) {",
+		"  3 |  \tlet n = 1;\t// one",
+		"    |  \t        ^",
+		"  4 |  }",
+		"",
+		"",
+	}, "\n"))
+}
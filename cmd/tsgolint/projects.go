@@ -0,0 +1,269 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/compiler"
+	"github.com/microsoft/typescript-go/shim/tsoptions"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/microsoft/typescript-go/shim/vfs"
+	"github.com/typescript-eslint/tsgolint/internal/linter"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+)
+
+// project is a tsconfig with the program its files are linted with.
+type project struct {
+	configFileName string
+	config         *tsoptions.ParsedCommandLine
+	// nil for solution-style tsconfigs without files of their own
+	program *compiler.Program
+	// the files this project lints
+	files []*ast.SourceFile
+}
+
+func (p *project) directory() string {
+	return tspath.GetDirectoryPath(p.configFileName)
+}
+
+// createProgram creates the program of p again, reading the files from fs.
+func (p *project) createProgram(singleThreaded bool, fs vfs.FS, timing *linter.Timing) (*compiler.Program, error) {
+	start := time.Now()
+	host := utils.CreateCompilerHost(p.directory(), fs)
+	config, err := utils.ParseTSConfig(fs, p.directory(), p.configFileName, host)
+	timing.AddPhase(linter.PhaseProgramCreation, time.Since(start))
+	if err != nil {
+		return nil, err
+	}
+	return newProgram(singleThreaded, config, host, timing)
+}
+
+func newProgram(singleThreaded bool, config *tsoptions.ParsedCommandLine, host compiler.CompilerHost, timing *linter.Timing) (*compiler.Program, error) {
+	start := time.Now()
+	program, err := utils.NewProgramFromConfig(singleThreaded, config, host)
+	timing.AddPhase(linter.PhaseProgramCreation, time.Since(start))
+	if err != nil {
+		return nil, err
+	}
+	start = time.Now()
+	program.BindSourceFiles()
+	timing.AddPhase(linter.PhaseBinding, time.Since(start))
+	return program, nil
+}
+
+// workspaceConfigFileNames are the tsconfigs --workspace looks for, in order
+// of preference. A tsconfig.eslint.json is usually written to include files,
+// like tests and scripts, that the build leaves out.
+var workspaceConfigFileNames = []string{"tsconfig.eslint.json", "tsconfig.json"}
+
+// findNearestTSConfig walks up from directory to the closest directory with a
+// tsconfig and returns its name, or "" if there is none.
+func findNearestTSConfig(fs vfs.FS, directory string) string {
+	for {
+		for _, name := range workspaceConfigFileNames {
+			if configFileName := tspath.CombinePaths(directory, name); fs.FileExists(configFileName) {
+				return configFileName
+			}
+		}
+		parent := tspath.GetDirectoryPath(directory)
+		if parent == directory {
+			return ""
+		}
+		directory = parent
+	}
+}
+
+// findTSConfigsIn returns the tsconfigs of the packages in directory and its
+// subdirectories, one per directory, except in node_modules and hidden
+// directories.
+func findTSConfigsIn(fs vfs.FS, directory string) []string {
+	var configFileNames []string
+	var walk func(directory string)
+	walk = func(directory string) {
+		for _, name := range workspaceConfigFileNames {
+			if configFileName := tspath.CombinePaths(directory, name); fs.FileExists(configFileName) {
+				configFileNames = append(configFileNames, configFileName)
+				break
+			}
+		}
+		for _, name := range slices.Sorted(slices.Values(fs.GetAccessibleEntries(directory).Directories)) {
+			if name == "node_modules" || strings.HasPrefix(name, ".") {
+				continue
+			}
+			walk(tspath.CombinePaths(directory, name))
+		}
+	}
+	walk(directory)
+	return configFileNames
+}
+
+// findWorkspaceTSConfigs returns the tsconfigs --workspace lints the targets
+// with: the nearest tsconfig of each target and the tsconfigs of the packages
+// in target directories. Targets get their nearest tsconfig for warnings.
+func findWorkspaceTSConfigs(fs vfs.FS, targets lintTargets, workingDirectory string) []string {
+	var configFileNames []string
+	add := func(configFileName string) {
+		if !slices.Contains(configFileNames, configFileName) {
+			configFileNames = append(configFileNames, configFileName)
+		}
+	}
+	for _, target := range targets {
+		if !target.exists {
+			continue
+		}
+		directory := target.fileName
+		if !target.isDirectory {
+			directory = tspath.GetDirectoryPath(directory)
+		}
+		var nested []string
+		if target.isDirectory {
+			nested = findTSConfigsIn(fs, directory)
+		}
+		configFileName := findNearestTSConfig(fs, directory)
+		if configFileName == "" && len(nested) == 0 {
+			target.noTSConfig = true
+			continue
+		}
+		if configFileName != "" {
+			target.tsconfig = tspath.ConvertToRelativePath(configFileName, tspath.ComparePathsOptions{
+				CurrentDirectory:          workingDirectory,
+				UseCaseSensitiveFileNames: fs.UseCaseSensitiveFileNames(),
+			})
+			add(configFileName)
+		}
+		for _, configFileName := range nested {
+			add(configFileName)
+		}
+	}
+	return configFileNames
+}
+
+// projects lints every file once, even if it is in the programs of several
+// projects. A file belongs to the first project listing it as a root file,
+// and otherwise to the first project that lints it. With --workspace, it
+// belongs to the project of its nearest tsconfig instead.
+type projects struct {
+	list       []*project
+	rootOwners map[tspath.Path]*project
+	claimed    map[tspath.Path]struct{}
+	// set with --workspace
+	nearestTSConfig func(fileName string) string
+}
+
+// loadProjects parses the given tsconfigs and the projects they reference,
+// transitively, and creates the programs of those with files.
+func loadProjects(singleThreaded bool, fs vfs.FS, configFileNames []string, timing *linter.Timing) (*projects, error) {
+	result := &projects{
+		rootOwners: map[tspath.Path]*project{},
+		claimed:    map[tspath.Path]struct{}{},
+	}
+
+	seen := map[tspath.Path]struct{}{}
+	// the tsconfigs being loaded, to find cycles, which leave the programs
+	// without the files of the projects in them
+	var loading []tspath.Path
+	var load func(configFileName string) error
+	load = func(configFileName string) error {
+		path := tspath.ToPath(configFileName, "", fs.UseCaseSensitiveFileNames())
+		if slices.Contains(loading, path) {
+			return fmt.Errorf("project references of %v form a cycle", configFileName)
+		}
+		if _, ok := seen[path]; ok {
+			return nil
+		}
+		seen[path] = struct{}{}
+		loading = append(loading, path)
+		defer func() {
+			loading = loading[:len(loading)-1]
+		}()
+
+		p := &project{configFileName: configFileName}
+		config, err := utils.ParseTSConfig(fs, p.directory(), configFileName, utils.CreateCompilerHost(p.directory(), fs))
+		if err != nil {
+			return err
+		}
+		p.config = config
+		result.list = append(result.list, p)
+		for _, fileName := range config.FileNames() {
+			filePath := tspath.ToPath(fileName, p.directory(), fs.UseCaseSensitiveFileNames())
+			if _, ok := result.rootOwners[filePath]; !ok {
+				result.rootOwners[filePath] = p
+			}
+		}
+
+		for _, reference := range config.ResolvedProjectReferencePaths() {
+			if !fs.FileExists(reference) {
+				return fmt.Errorf("tsconfig %v references %v, which doesn't exist", configFileName, reference)
+			}
+			if err := load(reference); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, configFileName := range configFileNames {
+		if err := load(configFileName); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, p := range result.list {
+		if len(p.config.FileNames()) == 0 {
+			continue
+		}
+		program, err := newProgram(singleThreaded, p.config, utils.CreateCompilerHost(p.directory(), fs), timing)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", p.configFileName, err)
+		}
+		p.program = program
+	}
+	return result, nil
+}
+
+// useNearestTSConfigs makes files belong to the project of their nearest
+// tsconfig, regardless of the order of the projects.
+func (ps *projects) useNearestTSConfigs(fs vfs.FS) {
+	nearest := map[string]string{}
+	ps.nearestTSConfig = func(fileName string) string {
+		directory := tspath.GetDirectoryPath(fileName)
+		configFileName, ok := nearest[directory]
+		if !ok {
+			configFileName = findNearestTSConfig(fs, directory)
+			nearest[directory] = configFileName
+		}
+		return configFileName
+	}
+}
+
+// canLint reports whether a file of the program of p may be linted with it,
+// which with --workspace only the project of its nearest tsconfig may.
+func (ps *projects) canLint(p *project, file *ast.SourceFile) bool {
+	return ps.nearestTSConfig == nil || ps.nearestTSConfig(file.FileName()) == p.configFileName
+}
+
+// claim reports whether a file of the program of p is linted with it.
+func (ps *projects) claim(p *project, file *ast.SourceFile) bool {
+	if ps.nearestTSConfig != nil {
+		return ps.canLint(p, file)
+	}
+	if owner, ok := ps.rootOwners[file.Path()]; ok {
+		return owner == p
+	}
+	if _, ok := ps.claimed[file.Path()]; ok {
+		return false
+	}
+	ps.claimed[file.Path()] = struct{}{}
+	return true
+}
+
+// resetFiles forgets which files are linted, so that they can be chosen again
+// after the programs changed.
+func (ps *projects) resetFiles() {
+	clear(ps.claimed)
+	for _, p := range ps.list {
+		p.files = nil
+	}
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/bundled"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/microsoft/typescript-go/shim/vfs"
+	"github.com/microsoft/typescript-go/shim/vfs/osvfs"
+	"github.com/typescript-eslint/tsgolint/internal/ignore"
+	"gotest.tools/v3/assert"
+)
+
+// writeFiles writes files, by their path relative to a new temporary
+// directory, and returns the directory.
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	directory := tspath.NormalizePath(t.TempDir())
+	for name, text := range files {
+		fileName := filepath.Join(directory, name)
+		assert.NilError(t, os.MkdirAll(filepath.Dir(fileName), 0o755))
+		assert.NilError(t, os.WriteFile(fileName, []byte(text), 0o644))
+	}
+	return directory
+}
+
+func newTestFS() vfs.FS {
+	return bundled.WrapFS(osvfs.FS())
+}
+
+func newTestSelector(fs vfs.FS, directory string, targets lintTargets, workspace bool) *fileSelector {
+	return &fileSelector{
+		fs:                   fs,
+		targets:              targets,
+		ignoreMatcher:        ignore.NewMatcher(directory, fs.UseCaseSensitiveFileNames()),
+		ignorePatternMatcher: ignore.NewMatcher(directory, fs.UseCaseSensitiveFileNames()),
+		comparePathOptions: tspath.ComparePathsOptions{
+			CurrentDirectory:          directory,
+			UseCaseSensitiveFileNames: fs.UseCaseSensitiveFileNames(),
+		},
+		workspace: workspace,
+	}
+}
+
+// lintedFiles maps the linted files to the projects they are linted with,
+// both relative to directory.
+func lintedFiles(ps *projects, directory string) map[string]string {
+	comparePathOptions := tspath.ComparePathsOptions{CurrentDirectory: directory}
+	files := map[string]string{}
+	for _, p := range ps.list {
+		for _, file := range p.files {
+			files[tspath.ConvertToRelativePath(file.FileName(), comparePathOptions)] = tspath.ConvertToRelativePath(p.configFileName, comparePathOptions)
+		}
+	}
+	return files
+}
+
+func loadWorkspace(t *testing.T, directory string, args []string) (*projects, lintTargets) {
+	t.Helper()
+
+	fs := newTestFS()
+	targets := newLintTargets(args, directory, fs)
+	ps, err := loadProjects(true, fs, findWorkspaceTSConfigs(fs, targets, directory), nil)
+	assert.NilError(t, err)
+	newTestSelector(fs, directory, targets, true).selectFiles(ps)
+	return ps, targets
+}
+
+var workspaceFiles = map[string]string{
+	"tsconfig.json":                `{"compilerOptions": {"strict": false}, "include": ["**/*.ts"]}`,
+	"src/root.ts":                  "export const root = 1;\n",
+	"packages/a/tsconfig.json":     `{"compilerOptions": {"strict": true}, "include": ["src"]}`,
+	"packages/a/src/a.ts":          "export const a = 1;\n",
+	"packages/a/scripts/build.ts":  "export const build = 1;\n",
+	"packages/b/tsconfig.json":     `{"compilerOptions": {"strict": true}}`,
+	"packages/b/index.ts":          "export const b = 1;\n",
+	"packages/b/node_modules/x.ts": "export const x = 1;\n",
+}
+
+func TestWorkspaceUsesNearestTSConfig(t *testing.T) {
+	directory := writeFiles(t, workspaceFiles)
+
+	for _, args := range [][]string{{"."}, {".", "packages/a"}, {"packages/a", "."}} {
+		ps, _ := loadWorkspace(t, directory, args)
+		assert.DeepEqual(t, lintedFiles(ps, directory), map[string]string{
+			"src/root.ts":         "tsconfig.json",
+			"packages/a/src/a.ts": "packages/a/tsconfig.json",
+			"packages/b/index.ts": "packages/b/tsconfig.json",
+		})
+		for _, p := range ps.list {
+			strict := strings.HasPrefix(p.configFileName, directory+"/packages/")
+			assert.Equal(t, p.config.CompilerOptions().Strict == core.TSTrue, strict, p.configFileName)
+		}
+	}
+}
+
+func TestWorkspaceWarnsAboutFilesOutsideOfNearestProgram(t *testing.T) {
+	directory := writeFiles(t, workspaceFiles)
+
+	// the root program includes the script, but its nearest tsconfig doesn't
+	ps, targets := loadWorkspace(t, directory, []string{"packages/a/scripts/build.ts"})
+	assert.DeepEqual(t, lintedFiles(ps, directory), map[string]string{})
+
+	var warnings strings.Builder
+	targets.printWarnings(&warnings, "")
+	assert.Equal(t, warnings.String(), "warning: packages/a/scripts/build.ts isn't part of the program of packages/a/tsconfig.json, so it isn't linted\n")
+}
+
+var referenceFiles = map[string]string{
+	"tsconfig.json":            `{"files": [], "references": [{"path": "packages/a"}, {"path": "packages/b"}]}`,
+	"packages/a/tsconfig.json": `{"compilerOptions": {"composite": true}, "include": ["src"]}`,
+	"packages/a/src/a.ts":      "export const a = 1;\n",
+	"packages/b/tsconfig.json": `{"compilerOptions": {"composite": true}, "include": ["src"], "references": [{"path": "../a"}]}`,
+	"packages/b/src/b.ts":      "import { a } from '../../a/src/a';\nexport const b = a;\n",
+}
+
+// loadTSConfigs loads the projects of the tsconfigs, relative to directory,
+// like --tsconfig does, and selects the files of their directories.
+func loadTSConfigs(t *testing.T, directory string, tsconfigs ...string) *projects {
+	t.Helper()
+
+	fs := newTestFS()
+	configFileNames := make([]string, len(tsconfigs))
+	for i, tsconfig := range tsconfigs {
+		configFileNames[i] = tspath.CombinePaths(directory, tsconfig)
+	}
+	ps, err := loadProjects(true, fs, configFileNames, nil)
+	assert.NilError(t, err)
+	newTestSelector(fs, directory, nil, false).selectFiles(ps)
+	return ps
+}
+
+func TestSolutionStyleTSConfig(t *testing.T) {
+	directory := writeFiles(t, referenceFiles)
+	ps := loadTSConfigs(t, directory, "tsconfig.json")
+
+	configNames := make([]string, len(ps.list))
+	for i, p := range ps.list {
+		configNames[i] = tspath.ConvertToRelativePath(p.configFileName, tspath.ComparePathsOptions{CurrentDirectory: directory})
+	}
+	assert.DeepEqual(t, configNames, []string{"tsconfig.json", "packages/a/tsconfig.json", "packages/b/tsconfig.json"})
+	// the solution has no files of its own
+	assert.Assert(t, ps.list[0].program == nil)
+	assert.DeepEqual(t, lintedFiles(ps, directory), map[string]string{
+		"packages/a/src/a.ts": "packages/a/tsconfig.json",
+		"packages/b/src/b.ts": "packages/b/tsconfig.json",
+	})
+}
+
+func TestFilesAreLintedWithTheProjectListingThem(t *testing.T) {
+	directory := writeFiles(t, referenceFiles)
+	// a.ts is in the program of b too, but a lists it
+	ps := loadTSConfigs(t, directory, "packages/b/tsconfig.json")
+	assert.DeepEqual(t, lintedFiles(ps, directory), map[string]string{
+		"packages/a/src/a.ts": "packages/a/tsconfig.json",
+		"packages/b/src/b.ts": "packages/b/tsconfig.json",
+	})
+}
+
+func TestOverlappingTSConfigs(t *testing.T) {
+	directory := writeFiles(t, map[string]string{
+		"tsconfig.json":      `{"include": ["src"]}`,
+		"tsconfig.test.json": `{"include": ["src", "test"]}`,
+		"src/a.ts":           "export const a = 1;\n",
+		"test/a.test.ts":     "export const test = 1;\n",
+	})
+
+	// files listed by several tsconfigs are linted with the first one, and
+	// once
+	for _, tsconfigs := range [][]string{{"tsconfig.json", "tsconfig.test.json"}, {"tsconfig.test.json", "tsconfig.json"}} {
+		ps := loadTSConfigs(t, directory, tsconfigs...)
+		assert.DeepEqual(t, lintedFiles(ps, directory), map[string]string{
+			"src/a.ts":       tsconfigs[0],
+			"test/a.test.ts": "tsconfig.test.json",
+		})
+	}
+}
+
+func TestCyclicProjectReferences(t *testing.T) {
+	directory := writeFiles(t, map[string]string{
+		"a/tsconfig.json": `{"compilerOptions": {"composite": true}, "references": [{"path": "../b"}]}`,
+		"a/a.ts":          "export const a = 1;\n",
+		"b/tsconfig.json": `{"compilerOptions": {"composite": true}, "references": [{"path": "../a"}]}`,
+		"b/b.ts":          "export const b = 1;\n",
+	})
+	_, err := loadProjects(true, newTestFS(), []string{tspath.CombinePaths(directory, "a/tsconfig.json")}, nil)
+	assert.Error(t, err, "project references of "+tspath.CombinePaths(directory, "a/tsconfig.json")+" form a cycle")
+}
+
+func TestMissingProjectReference(t *testing.T) {
+	directory := writeFiles(t, map[string]string{
+		"tsconfig.json": `{"files": [], "references": [{"path": "missing"}]}`,
+	})
+	_, err := loadProjects(true, newTestFS(), []string{tspath.CombinePaths(directory, "tsconfig.json")}, nil)
+	assert.ErrorContains(t, err, "references "+tspath.CombinePaths(directory, "missing/tsconfig.json")+", which doesn't exist")
+}
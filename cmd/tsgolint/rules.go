@@ -0,0 +1,40 @@
+package main
+
+import (
+	"sync"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/typescript-eslint/tsgolint/internal/config"
+	"github.com/typescript-eslint/tsgolint/internal/linter"
+)
+
+// fileRules gets the rules of files for the linter, which can't fail, from a
+// rule set, which can. Files whose rules can't be configured get none, and
+// the first error is kept to be reported after linting.
+type fileRules struct {
+	set *config.RuleSet
+
+	mu  sync.Mutex
+	err error
+}
+
+func (r *fileRules) get(sourceFile *ast.SourceFile) []linter.ConfiguredRule {
+	rules, err := r.set.RulesForFile(sourceFile.FileName())
+	if err != nil {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.err == nil {
+			r.err = err
+		}
+	}
+	return rules
+}
+
+// takeErr returns the first error since the last call and forgets it.
+func (r *fileRules) takeErr() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	err := r.err
+	r.err = nil
+	return err
+}
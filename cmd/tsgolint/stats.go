@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/formatter"
+	"github.com/typescript-eslint/tsgolint/internal/linter"
+)
+
+// how many of the slowest files --stats prints without --stats-count
+const defaultStatsCount = 10
+
+func newStatsReport(stats *linter.Stats, comparePathOptions tspath.ComparePathsOptions) []formatter.FileStats {
+	files := stats.Files()
+	report := make([]formatter.FileStats, len(files))
+	for i, s := range files {
+		report[i] = formatter.FileStats{
+			File:          tspath.ConvertToRelativePath(s.FileName, comparePathOptions),
+			ListenersTime: milliseconds(s.ListenersTime),
+			TypeCheckTime: milliseconds(s.TypeCheckTime),
+			Nodes:         s.Nodes,
+			Diagnostics:   s.Diagnostics,
+		}
+	}
+	return report
+}
+
+// printStats prints a table of the count slowest files.
+func printStats(out io.Writer, stats *linter.Stats, count int, comparePathOptions tspath.ComparePathsOptions, colors bool) {
+	b := bufio.NewWriter(out)
+	defer b.Flush()
+
+	files := stats.Files()
+	shown := files[:min(len(files), count)]
+	fileNames := make([]string, len(shown))
+	nameWidth := len("File")
+	for i, s := range shown {
+		fileNames[i] = tspath.ConvertToRelativePath(s.FileName, comparePathOptions)
+		nameWidth = max(nameWidth, len(fileNames[i]))
+	}
+
+	b.WriteByte('\n')
+	printSummary(b, colors, "\x1b[1m%-*v  %10v  %10v  %10v  %8v  %8v\x1b[0m\n", nameWidth, "File", "Time (ms)", "Rules", "Type check", "Nodes", "Problems")
+	for i, s := range shown {
+		printSummary(
+			b,
+			colors,
+			"%-*v  %10.3f  %10.3f  %10.3f  %8v  %8v\n",
+			nameWidth,
+			fileNames[i],
+			milliseconds(s.Time()),
+			milliseconds(s.ListenersTime),
+			milliseconds(s.TypeCheckTime),
+			s.Nodes,
+			s.Diagnostics,
+		)
+	}
+	if len(files) > len(shown) {
+		printSummary(b, colors, "\x1b[2m%v more files\x1b[0m\n", len(files)-len(shown))
+	}
+}
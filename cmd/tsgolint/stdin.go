@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/microsoft/typescript-go/shim/vfs"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+)
+
+// stdinFile is the text read with --stdin, linted as the file at fileName.
+type stdinFile struct {
+	fileName string
+	text     string
+}
+
+// readStdin reads the text to lint from r and overlays it onto fs at
+// --stdin-filename, relative to the working directory. The rest of the
+// program is read from disk, so that imports resolve.
+func readStdin(r io.Reader, fs vfs.FS, workingDirectory string, stdinFilename string) (vfs.FS, stdinFile, error) {
+	text, err := io.ReadAll(r)
+	if err != nil {
+		return nil, stdinFile{}, fmt.Errorf("error reading stdin: %w", err)
+	}
+	file := stdinFile{
+		fileName: tspath.ResolvePath(workingDirectory, stdinFilename),
+		text:     string(text),
+	}
+	return utils.NewOverlayVFS(fs, map[string]string{file.fileName: file.text}), file, nil
+}
+
+// fixedText returns the text with the fixes applied, which is the text read
+// if nothing was fixed.
+func (f stdinFile) fixedText(fixedFiles map[string]string) string {
+	if fixedText, ok := fixedFiles[f.fileName]; ok {
+		return fixedText
+	}
+	return f.text
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"gotest.tools/v3/assert"
+)
+
+var stdinFiles = map[string]string{
+	"tsconfig.json": `{"include": ["src"]}`,
+	"src/a.ts":      "export const a = 1;\n",
+	"src/b.ts":      "import { a } from './a';\nexport const b = a;\n",
+}
+
+// lintStdin selects the files linted with text read from stdin as
+// stdinFilename, relative to workingDirectory, and returns the stdin file,
+// the projects and the project directory.
+func lintStdin(t *testing.T, workingDirectory string, stdinFilename string, text string) (stdinFile, *projects, string) {
+	t.Helper()
+
+	directory := writeFiles(t, stdinFiles)
+	workingDirectory = tspath.ResolvePath(directory, workingDirectory)
+	fs, file, err := readStdin(strings.NewReader(text), newTestFS(), workingDirectory, stdinFilename)
+	assert.NilError(t, err)
+
+	ps, err := loadProjects(true, fs, []string{tspath.CombinePaths(directory, "tsconfig.json")}, nil)
+	assert.NilError(t, err)
+	newTestSelector(fs, workingDirectory, newLintTargets([]string{stdinFilename}, workingDirectory, fs), false).selectFiles(ps)
+	return file, ps, directory
+}
+
+func TestStdinReplacesFile(t *testing.T) {
+	text := "export const a = 'a';\n"
+	file, ps, directory := lintStdin(t, "src", "a.ts", text)
+
+	// the name is relative to where tsgolint runs
+	assert.Equal(t, file.fileName, tspath.CombinePaths(directory, "src/a.ts"))
+	assert.Equal(t, file.text, text)
+	assert.DeepEqual(t, lintedFiles(ps, directory), map[string]string{"src/a.ts": "tsconfig.json"})
+
+	// the program sees the text from stdin, and the files on disk import it
+	program := ps.list[0].program
+	assert.Equal(t, program.GetSourceFile(file.fileName).Text(), text)
+	checker, done := program.GetTypeChecker(t.Context())
+	defer done()
+	b := program.GetSourceFile(tspath.CombinePaths(directory, "src/b.ts"))
+	bType := checker.GetTypeAtLocation(b.Statements.Nodes[1].AsVariableStatement().DeclarationList.AsVariableDeclarationList().Declarations.Nodes[0].Name())
+	assert.Equal(t, checker.TypeToString(bType), `"a"`)
+}
+
+func TestStdinFileThatDoesNotExist(t *testing.T) {
+	text := "import { b } from './b';\nexport const c = b;\n"
+	file, ps, directory := lintStdin(t, ".", "src/c.ts", text)
+
+	assert.DeepEqual(t, lintedFiles(ps, directory), map[string]string{"src/c.ts": "tsconfig.json"})
+	assert.Equal(t, ps.list[0].program.GetSourceFile(file.fileName).Text(), text)
+}
+
+func TestStdinFixedText(t *testing.T) {
+	file := stdinFile{fileName: "/project/src/a.ts", text: "const a = 1;\n"}
+	assert.Equal(t, file.fixedText(map[string]string{"/project/src/b.ts": "const b = 2;\n"}), "const a = 1;\n")
+	assert.Equal(t, file.fixedText(map[string]string{"/project/src/a.ts": "const a = 2;\n"}), "const a = 2;\n")
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/typescript-eslint/tsgolint/internal/formatter"
+	"github.com/typescript-eslint/tsgolint/internal/linter"
+)
+
+// timingRulesCount is how many of the slowest rules --timing prints, like
+// ESLint's TIMING. The JSON report has all of them.
+const timingRulesCount = 10
+
+var phaseTitles = map[linter.Phase]string{
+	linter.PhaseProgramCreation: "Program creation",
+	linter.PhaseBinding:         "Binding",
+	linter.PhaseTypeChecking:    "Type checking",
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func percentOf(d time.Duration, total time.Duration) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(d) / float64(total) * 100
+}
+
+func totalRulesTime(rules []linter.RuleTiming) time.Duration {
+	var total time.Duration
+	for _, r := range rules {
+		total += r.Time
+	}
+	return total
+}
+
+// timedPhases are the phases measured in a run. Type checking only happens
+// with --type-check, while the types rules ask for count toward the rules.
+func timedPhases(typeCheck bool) []linter.Phase {
+	if typeCheck {
+		return linter.Phases
+	}
+	return slices.DeleteFunc(slices.Clone(linter.Phases), func(phase linter.Phase) bool {
+		return phase == linter.PhaseTypeChecking
+	})
+}
+
+func newTimingReport(timing *linter.Timing, typeCheck bool) formatter.Timing {
+	rules := timing.Rules()
+	total := totalRulesTime(rules)
+	report := formatter.Timing{
+		ProgramCreation: milliseconds(timing.Phase(linter.PhaseProgramCreation)),
+		Binding:         milliseconds(timing.Phase(linter.PhaseBinding)),
+		Rules:           make([]formatter.RuleTiming, len(rules)),
+	}
+	if typeCheck {
+		typeChecking := milliseconds(timing.Phase(linter.PhaseTypeChecking))
+		report.TypeChecking = &typeChecking
+	}
+	for i, r := range rules {
+		report.Rules[i] = formatter.RuleTiming{
+			Rule:    r.Name,
+			Time:    milliseconds(r.Time),
+			Percent: percentOf(r.Time, total),
+		}
+	}
+	return report
+}
+
+// printTiming prints a table of the slowest rules, followed by the other
+// phases of linting.
+func printTiming(out io.Writer, timing *linter.Timing, typeCheck bool, colors bool) {
+	b := bufio.NewWriter(out)
+	defer b.Flush()
+
+	rules := timing.Rules()
+	total := totalRulesTime(rules)
+	shown := rules[:min(len(rules), timingRulesCount)]
+
+	nameWidth := len("All rules")
+	for _, r := range shown {
+		nameWidth = max(nameWidth, len(r.Name))
+	}
+	for _, title := range phaseTitles {
+		nameWidth = max(nameWidth, len(title))
+	}
+
+	b.WriteByte('\n')
+	printSummary(b, colors, "\x1b[1m%-*v  %10v  %8v\x1b[0m\n", nameWidth, "Rule", "Time (ms)", "Relative")
+	for _, r := range shown {
+		printSummary(b, colors, "%-*v  %10.3f  %7.1f%%\n", nameWidth, r.Name, milliseconds(r.Time), percentOf(r.Time, total))
+	}
+	if len(rules) > len(shown) {
+		printSummary(b, colors, "\x1b[2m%v more rules\x1b[0m\n", len(rules)-len(shown))
+	}
+	printSummary(b, colors, "\x1b[2m%v\x1b[0m\n", strings.Repeat("-", nameWidth+2+10))
+	printSummary(b, colors, "%-*v  %10.3f\n", nameWidth, "All rules", milliseconds(total))
+	for _, phase := range timedPhases(typeCheck) {
+		printSummary(b, colors, "%-*v  %10.3f\n", nameWidth, phaseTitles[phase], milliseconds(timing.Phase(phase)))
+	}
+	if !typeCheck {
+		printSummary(b, colors, "\x1b[2mType checking is only timed with --type-check. The types rules ask for count toward the rules.\x1b[0m\n")
+	}
+}
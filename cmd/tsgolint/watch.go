@@ -0,0 +1,410 @@
+package main
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/microsoft/typescript-go/shim/vfs"
+	"github.com/microsoft/typescript-go/shim/vfs/cachedvfs"
+	"github.com/typescript-eslint/tsgolint/internal/baseline"
+	"github.com/typescript-eslint/tsgolint/internal/linter"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+)
+
+// watchInterval is how often the watched files are checked for changes.
+// Polling works the same everywhere, and a stat per file is cheap next to
+// linting.
+const watchInterval = 300 * time.Millisecond
+
+// watcher keeps the programs of the projects up to date with the files on
+// disk and lints again what a change can affect.
+type watcher struct {
+	singleThreaded  bool
+	fs              vfs.FS
+	cachedFS        *cachedvfs.FS
+	configFileNames []string
+	selectFiles     func(ps *projects)
+	rules           *fileRules
+	linterOptions   linter.Options
+	// nil without a baseline
+	newBaselineTracker func() *baseline.Tracker
+	rulesCount         int
+	colors             bool
+	comparePathOptions tspath.ComparePathsOptions
+
+	projects *projects
+	// problems by the name of the linted file, and global TS diagnostics by
+	// the name of the tsconfig
+	diagnostics map[string][]rule.RuleDiagnostic
+	modTimes    map[string]time.Time
+}
+
+// watchedFileNames lists the files whose changes need linting again: the
+// tsconfigs, the files of the programs outside of node_modules, and the
+// directories of root files, which change when files are added or removed.
+func (w *watcher) watchedFileNames() []string {
+	var fileNames []string
+	seen := map[string]struct{}{}
+	add := func(fileName string) {
+		if _, ok := seen[fileName]; !ok {
+			seen[fileName] = struct{}{}
+			fileNames = append(fileNames, fileName)
+		}
+	}
+	for _, p := range w.projects.list {
+		add(p.configFileName)
+		if p.config.ConfigFile != nil {
+			for _, extended := range p.config.ConfigFile.ExtendedSourceFiles {
+				add(extended)
+			}
+		}
+		for _, fileName := range p.config.FileNames() {
+			for directory := tspath.GetDirectoryPath(fileName); tspath.ContainsPath(p.directory(), directory, w.comparePathOptions); directory = tspath.GetDirectoryPath(directory) {
+				if _, ok := seen[directory]; ok {
+					break
+				}
+				add(directory)
+				if tspath.ComparePaths(directory, p.directory(), w.comparePathOptions) == 0 {
+					break
+				}
+			}
+		}
+		if p.program == nil {
+			continue
+		}
+		for _, file := range p.program.SourceFiles() {
+			if strings.Contains(file.FileName(), "/node_modules/") || p.program.IsSourceFileDefaultLibrary(file.Path()) {
+				continue
+			}
+			add(file.FileName())
+		}
+	}
+	return fileNames
+}
+
+// snapshot returns the modification times of the watched files. Deleted files
+// get the zero time.
+func (w *watcher) snapshot() map[string]time.Time {
+	modTimes := map[string]time.Time{}
+	for _, fileName := range w.watchedFileNames() {
+		var modTime time.Time
+		if info, err := os.Stat(fileName); err == nil {
+			modTime = info.ModTime()
+		}
+		modTimes[fileName] = modTime
+	}
+	return modTimes
+}
+
+func (w *watcher) lint(p *project, files []*ast.SourceFile) error {
+	for _, file := range files {
+		delete(w.diagnostics, file.FileName())
+	}
+	var diagnosticsMu sync.Mutex
+	err := linter.RunLinter(p.program, w.singleThreaded, files, w.rules.get, w.linterOptions, func(d rule.RuleDiagnostic) {
+		diagnosticsMu.Lock()
+		defer diagnosticsMu.Unlock()
+		w.diagnostics[d.SourceFile.FileName()] = append(w.diagnostics[d.SourceFile.FileName()], d)
+	})
+	if err == nil {
+		err = w.rules.takeErr()
+	}
+	if err != nil {
+		return err
+	}
+	if w.linterOptions.TypeCheck {
+		w.diagnostics[p.configFileName] = linter.GetGlobalDiagnostics(p.program, p.config.ConfigFile.SourceFile)
+	}
+	return nil
+}
+
+func (w *watcher) lintAll() error {
+	w.diagnostics = map[string][]rule.RuleDiagnostic{}
+	for _, p := range w.projects.list {
+		if len(p.files) == 0 {
+			continue
+		}
+		if err := w.lint(p, p.files); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// changedPathsByProject finds the changed files in the programs of the
+// projects. Changes of tsconfigs and directories, which is how added files
+// show up, and deleted files need the projects to be loaded again.
+func changedPathsByProject(ps *projects, changed []string, fileExists func(fileName string) bool) (changedPaths map[*project][]tspath.Path, reload bool) {
+	changedPaths = map[*project][]tspath.Path{}
+	for _, fileName := range changed {
+		if !fileExists(fileName) {
+			reload = true
+			continue
+		}
+		inProgram := false
+		for _, p := range ps.list {
+			if p.program == nil {
+				continue
+			}
+			if file := p.program.GetSourceFile(fileName); file != nil {
+				changedPaths[p] = append(changedPaths[p], file.Path())
+				inProgram = true
+			}
+		}
+		if !inProgram {
+			reload = true
+		}
+	}
+	return changedPaths, reload
+}
+
+// filesToLintAgain returns the files of p to lint after its program was
+// updated with the changed files: the linted files importing them, directly or
+// through other files, and files that weren't linted before, like newly
+// imported ones.
+func filesToLintAgain(p *project, changedPaths []tspath.Path, lintedBefore map[tspath.Path]struct{}) []*ast.SourceFile {
+	lintedFiles := make(map[tspath.Path]struct{}, len(p.files))
+	for _, file := range p.files {
+		lintedFiles[file.Path()] = struct{}{}
+	}
+
+	var changedFiles []*ast.SourceFile
+	for _, path := range changedPaths {
+		if file := p.program.GetSourceFileByPath(path); file != nil {
+			changedFiles = append(changedFiles, file)
+		}
+	}
+	var filesToLint []*ast.SourceFile
+	if len(changedFiles) > 0 {
+		for _, file := range utils.GetDependents(p.program, changedFiles) {
+			if _, ok := lintedFiles[file.Path()]; ok {
+				filesToLint = append(filesToLint, file)
+			}
+		}
+	}
+	for _, file := range p.files {
+		if _, ok := lintedBefore[file.Path()]; !ok && !slices.Contains(filesToLint, file) {
+			filesToLint = append(filesToLint, file)
+		}
+	}
+	return filesToLint
+}
+
+// update brings the programs up to date with the changed files and lints the
+// files that import them. Changes of tsconfigs and directories load the
+// projects again.
+func (w *watcher) update(changed []string) error {
+	w.cachedFS.ClearCache()
+
+	changedPaths, reload := changedPathsByProject(w.projects, changed, w.fs.FileExists)
+	if reload {
+		// nil, since --timing can't be used with --watch
+		ps, err := loadProjects(w.singleThreaded, w.fs, w.configFileNames, nil)
+		if err != nil {
+			return err
+		}
+		w.projects = ps
+		w.selectFiles(ps)
+		return w.lintAll()
+	}
+
+	linted := map[tspath.Path]struct{}{}
+	for _, p := range w.projects.list {
+		for _, file := range p.files {
+			linted[file.Path()] = struct{}{}
+		}
+	}
+
+	// when imports change, the program is created again and files can come
+	// and go, otherwise only the changed files are parsed again
+	reused := true
+	for p, paths := range changedPaths {
+		for _, path := range paths {
+			var reusedProgram bool
+			p.program, reusedProgram = p.program.UpdateProgram(path)
+			reused = reused && reusedProgram
+		}
+		p.program.BindSourceFiles()
+	}
+	if reused {
+		for p := range changedPaths {
+			p.files = utils.Map(p.files, func(file *ast.SourceFile) *ast.SourceFile {
+				return p.program.GetSourceFileByPath(file.Path())
+			})
+		}
+	} else {
+		w.selectFiles(w.projects)
+	}
+
+	for _, p := range w.projects.list {
+		if p.program == nil {
+			continue
+		}
+		if filesToLint := filesToLintAgain(p, changedPaths[p], linted); len(filesToLint) > 0 {
+			if err := w.lint(p, filesToLint); err != nil {
+				return err
+			}
+		}
+	}
+
+	// forget the problems of files that aren't linted anymore
+	current := map[string]struct{}{}
+	for _, p := range w.projects.list {
+		current[p.configFileName] = struct{}{}
+		for _, file := range p.files {
+			current[file.FileName()] = struct{}{}
+		}
+	}
+	for fileName := range w.diagnostics {
+		if _, ok := current[fileName]; !ok {
+			delete(w.diagnostics, fileName)
+		}
+	}
+	return nil
+}
+
+// print redraws the current problems, clearing the terminal first.
+func (w *watcher) print(out io.Writer, duration time.Duration, updateErr error) {
+	b := bufio.NewWriter(out)
+	defer b.Flush()
+	if w.colors {
+		b.WriteString("\x1b[2J\x1b[3J\x1b[H")
+	} else {
+		b.WriteByte('\n')
+	}
+
+	var baselineTracker *baseline.Tracker
+	if w.newBaselineTracker != nil {
+		baselineTracker = w.newBaselineTracker()
+	}
+	fileNames := slices.Sorted(func(yield func(string) bool) {
+		for fileName := range w.diagnostics {
+			if !yield(fileName) {
+				return
+			}
+		}
+	})
+	var diagnostics []rule.RuleDiagnostic
+	for _, fileName := range fileNames {
+		diagnostics = append(diagnostics, slices.SortedStableFunc(slices.Values(w.diagnostics[fileName]), func(a rule.RuleDiagnostic, b rule.RuleDiagnostic) int {
+			return cmp.Compare(a.Range.Pos(), b.Range.Pos())
+		})...)
+	}
+	if baselineTracker != nil {
+		diagnostics = baselineTracker.AddAll(diagnostics)
+	}
+	errorsCount := 0
+	warningsCount := 0
+	for _, d := range diagnostics {
+		if d.Severity == rule.SeverityWarning {
+			warningsCount++
+		} else {
+			errorsCount++
+		}
+		if errorsCount+warningsCount == 1 {
+			b.WriteByte('\n')
+		}
+		printDiagnostic(d, b, w.comparePathOptions, w.colors)
+	}
+
+	if updateErr != nil {
+		printSummary(b, w.colors, "\x1b[1;31merror:\x1b[0m %v\n", updateErr)
+	}
+	errorsColor := "\x1b[1m"
+	if errorsCount == 0 {
+		errorsColor = "\x1b[1;32m"
+	}
+	errorsText := "errors"
+	if errorsCount == 1 {
+		errorsText = "error"
+	}
+	warningsText := ""
+	if warningsCount == 1 {
+		warningsText = " and \x1b[1;33m1\x1b[0m warning"
+	} else if warningsCount > 1 {
+		warningsText = fmt.Sprintf(" and \x1b[1;33m%v\x1b[0m warnings", warningsCount)
+	}
+	filesCount := 0
+	for _, p := range w.projects.list {
+		filesCount += len(p.files)
+	}
+	filesText := "files"
+	if filesCount == 1 {
+		filesText = "file"
+	}
+	rulesText := "rules"
+	if w.rulesCount == 1 {
+		rulesText = "rule"
+	}
+	printSummary(
+		b,
+		w.colors,
+		"Found %v%v\x1b[0m %v%v \x1b[2m(%v %v with %v %v, updated in \x1b[1m%v\x1b[22m\x1b[2m)\x1b[0m\n",
+		errorsColor,
+		errorsCount,
+		errorsText,
+		warningsText,
+		filesCount,
+		filesText,
+		w.rulesCount,
+		rulesText,
+		duration.Round(time.Millisecond),
+	)
+	printSummary(b, w.colors, "\x1b[2mWatching for changes, press Ctrl+C to stop\x1b[0m\n")
+}
+
+// run lints all files and then watches them until ctx is done.
+func (w *watcher) run(ctx context.Context, out io.Writer) int {
+	start := time.Now()
+	if err := w.lintAll(); err != nil {
+		fmt.Fprintf(os.Stderr, "error running linter: %v\n", err)
+		return 1
+	}
+	w.modTimes = w.snapshot()
+	w.print(out, time.Since(start), nil)
+
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return 0
+		case <-ticker.C:
+		}
+
+		modTimes := w.snapshot()
+		var changed []string
+		for fileName, modTime := range modTimes {
+			if previous, ok := w.modTimes[fileName]; ok && !previous.Equal(modTime) {
+				changed = append(changed, fileName)
+			}
+		}
+		if len(changed) == 0 {
+			w.modTimes = modTimes
+			continue
+		}
+
+		start := time.Now()
+		err := w.update(changed)
+		// files changed while linting show up in the next snapshot
+		next := w.snapshot()
+		for fileName, modTime := range modTimes {
+			if _, ok := next[fileName]; ok {
+				next[fileName] = modTime
+			}
+		}
+		w.modTimes = next
+		w.print(out, time.Since(start), err)
+	}
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"gotest.tools/v3/assert"
+)
+
+var watchFiles = map[string]string{
+	"tsconfig.json": `{"include": ["src"]}`,
+	"src/a.ts":      "import { b } from './b';\nexport const a = b;\n",
+	"src/b.ts":      "export const b = 1;\n",
+	"src/c.ts":      "export const c = 1;\n",
+}
+
+func loadWatchedProjects(t *testing.T) (*projects, string) {
+	t.Helper()
+
+	directory := writeFiles(t, watchFiles)
+	fs := newTestFS()
+	ps, err := loadProjects(true, fs, []string{tspath.CombinePaths(directory, "tsconfig.json")}, nil)
+	assert.NilError(t, err)
+	newTestSelector(fs, directory, nil, false).selectFiles(ps)
+	return ps, directory
+}
+
+func lintedPaths(ps *projects) map[tspath.Path]struct{} {
+	linted := map[tspath.Path]struct{}{}
+	for _, p := range ps.list {
+		for _, file := range p.files {
+			linted[file.Path()] = struct{}{}
+		}
+	}
+	return linted
+}
+
+func baseFileNames(files []*ast.SourceFile) []string {
+	names := make([]string, len(files))
+	for i, file := range files {
+		names[i] = tspath.GetBaseFileName(file.FileName())
+	}
+	slices.Sort(names)
+	return names
+}
+
+// filesToLintAfter returns the files linted again after the files, relative
+// to the project directory, changed, or nil if the projects are loaded again.
+func filesToLintAfter(t *testing.T, ps *projects, directory string, changed ...string) []string {
+	t.Helper()
+
+	fileNames := make([]string, len(changed))
+	for i, name := range changed {
+		fileNames[i] = tspath.CombinePaths(directory, name)
+	}
+	changedPaths, reload := changedPathsByProject(ps, fileNames, newTestFS().FileExists)
+	if reload {
+		return nil
+	}
+	var files []*ast.SourceFile
+	for _, p := range ps.list {
+		files = append(files, filesToLintAgain(p, changedPaths[p], lintedPaths(ps))...)
+	}
+	return baseFileNames(files)
+}
+
+func TestWatchEditedFile(t *testing.T) {
+	ps, directory := loadWatchedProjects(t)
+	assert.DeepEqual(t, filesToLintAfter(t, ps, directory, "src/c.ts"), []string{"c.ts"})
+}
+
+func TestWatchDependentOfEditedFile(t *testing.T) {
+	ps, directory := loadWatchedProjects(t)
+	assert.DeepEqual(t, filesToLintAfter(t, ps, directory, "src/b.ts"), []string{"a.ts", "b.ts"})
+}
+
+func TestWatchNewFile(t *testing.T) {
+	ps, directory := loadWatchedProjects(t)
+	// added files show up as changes of their directory
+	assert.NilError(t, os.WriteFile(tspath.CombinePaths(directory, "src/d.ts"), []byte("export const d = 1;\n"), 0o644))
+	assert.Assert(t, filesToLintAfter(t, ps, directory, "src") == nil)
+
+	// a file that is linted after an update for the first time, like a newly
+	// imported one, is linted along with the changed files
+	p := ps.list[0]
+	lintedBefore := lintedPaths(ps)
+	delete(lintedBefore, p.program.GetSourceFile(tspath.CombinePaths(directory, "src/c.ts")).Path())
+	assert.DeepEqual(t, baseFileNames(filesToLintAgain(p, nil, lintedBefore)), []string{"c.ts"})
+}
+
+func TestWatchDeletedFile(t *testing.T) {
+	ps, directory := loadWatchedProjects(t)
+	assert.NilError(t, os.Remove(tspath.CombinePaths(directory, "src/b.ts")))
+	assert.Assert(t, filesToLintAfter(t, ps, directory, "src/b.ts") == nil)
+}
+
+func TestWatchTSConfigChange(t *testing.T) {
+	ps, directory := loadWatchedProjects(t)
+	assert.Assert(t, filesToLintAfter(t, ps, directory, "tsconfig.json") == nil)
+}
@@ -0,0 +1,145 @@
+// Package baseline records the problems a project already has, so that rules
+// can be turned on for legacy code and only new problems are reported.
+package baseline
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/microsoft/typescript-go/shim/vfs"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+)
+
+// Counts of problems by file, rule name and message id. Lines aren't
+// recorded, so that the counts survive unrelated edits.
+type Counts map[string]map[string]map[string]int
+
+func (c Counts) get(file, ruleName, messageId string) int {
+	return c[file][ruleName][messageId]
+}
+
+func (c Counts) set(file, ruleName, messageId string, count int) {
+	rules, ok := c[file]
+	if !ok {
+		rules = map[string]map[string]int{}
+		c[file] = rules
+	}
+	messages, ok := rules[ruleName]
+	if !ok {
+		messages = map[string]int{}
+		rules[ruleName] = messages
+	}
+	messages[messageId] = count
+}
+
+type Baseline struct {
+	// file names are relative to the baseline file
+	Files Counts `json:"files"`
+}
+
+func Load(fs vfs.FS, fileName string) (*Baseline, error) {
+	text, ok := fs.ReadFile(fileName)
+	if !ok {
+		return nil, fmt.Errorf("couldn't read baseline at %v", fileName)
+	}
+	var baseline Baseline
+	if err := utils.DecodeJSONStrict([]byte(text), &baseline); err != nil {
+		return nil, err
+	}
+	if baseline.Files == nil {
+		baseline.Files = Counts{}
+	}
+	return &baseline, nil
+}
+
+// Marshal encodes the baseline with sorted keys, to keep diffs of the
+// committed file small.
+func (b *Baseline) Marshal() ([]byte, error) {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// Tracker counts the problems of a run and tells which of them go beyond a
+// baseline. It isn't safe for concurrent use.
+type Tracker struct {
+	baseline           *Baseline
+	comparePathOptions tspath.ComparePathsOptions
+	seen               Counts
+}
+
+// NewTracker creates a tracker for a baseline file in baselineDirectory.
+// baseline may be nil, in which case every problem is new.
+func NewTracker(baseline *Baseline, baselineDirectory string, useCaseSensitiveFileNames bool) *Tracker {
+	return &Tracker{
+		baseline: baseline,
+		comparePathOptions: tspath.ComparePathsOptions{
+			CurrentDirectory:          baselineDirectory,
+			UseCaseSensitiveFileNames: useCaseSensitiveFileNames,
+		},
+		seen: Counts{},
+	}
+}
+
+// Add counts a problem and reports whether it is beyond the baseline. Once a
+// file has more problems of a kind than recorded, the extra ones are new, so
+// the problems of a file have to be added in a fixed order, like AddAll does.
+func (t *Tracker) Add(d rule.RuleDiagnostic) bool {
+	file := tspath.ConvertToRelativePath(d.SourceFile.FileName(), t.comparePathOptions)
+	count := t.seen.get(file, d.RuleName, d.Message.Id) + 1
+	t.seen.set(file, d.RuleName, d.Message.Id, count)
+	return t.baseline == nil || count > t.baseline.Files.get(file, d.RuleName, d.Message.Id)
+}
+
+// AddAll adds the problems by file and position, and returns those beyond the
+// baseline in that order, so that which ones are new doesn't depend on the
+// order they were found in.
+func (t *Tracker) AddAll(diagnostics []rule.RuleDiagnostic) []rule.RuleDiagnostic {
+	sorted := slices.SortedStableFunc(slices.Values(diagnostics), func(a rule.RuleDiagnostic, b rule.RuleDiagnostic) int {
+		return cmp.Or(
+			strings.Compare(a.SourceFile.FileName(), b.SourceFile.FileName()),
+			cmp.Compare(a.Range.Pos(), b.Range.Pos()),
+			cmp.Compare(a.Range.End(), b.Range.End()),
+			strings.Compare(a.RuleName, b.RuleName),
+			strings.Compare(a.Message.Id, b.Message.Id),
+		)
+	})
+	var newDiagnostics []rule.RuleDiagnostic
+	for _, d := range sorted {
+		if t.Add(d) {
+			newDiagnostics = append(newDiagnostics, d)
+		}
+	}
+	return newDiagnostics
+}
+
+// Current is a baseline of every problem added so far.
+func (t *Tracker) Current() *Baseline {
+	return &Baseline{Files: t.seen}
+}
+
+// Pruned is the baseline without the problems that no longer occur. Counts
+// only go down, so new problems are never added to it.
+func (t *Tracker) Pruned() *Baseline {
+	pruned := Counts{}
+	if t.baseline == nil {
+		return &Baseline{Files: pruned}
+	}
+	for file, rules := range t.baseline.Files {
+		for ruleName, messages := range rules {
+			for messageId, count := range messages {
+				if count = min(count, t.seen.get(file, ruleName, messageId)); count > 0 {
+					pruned.set(file, ruleName, messageId, count)
+				}
+			}
+		}
+	}
+	return &Baseline{Files: pruned}
+}
@@ -0,0 +1,94 @@
+package baseline
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/rules/fixtures"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+	"gotest.tools/v3/assert"
+)
+
+func testSourceFile(t *testing.T) *ast.SourceFile {
+	t.Helper()
+
+	rootDir := fixtures.GetRootDir()
+	fileName := tspath.ResolvePath(rootDir, "file.ts")
+	fs := utils.NewOverlayVFSForFile(fileName, "const a = 1;\n")
+	program, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.json", utils.CreateCompilerHost(rootDir, fs))
+	assert.NilError(t, err)
+	return program.GetSourceFile(fileName)
+}
+
+func diagnostic(file *ast.SourceFile, ruleName string, messageId string) rule.RuleDiagnostic {
+	return rule.RuleDiagnostic{
+		RuleName:   ruleName,
+		Message:    rule.RuleMessage{Id: messageId},
+		SourceFile: file,
+	}
+}
+
+func TestTracker(t *testing.T) {
+	file := testSourceFile(t)
+	rootDir := fixtures.GetRootDir()
+
+	baseline, err := Load(utils.NewOverlayVFS(utils.NewOverlayVFSForFile(file.FileName(), file.Text()), map[string]string{
+		tspath.ResolvePath(rootDir, "baseline.json"): `{"files": {"file.ts": {"no-unsafe-call": {"unsafeCall": 2}, "no-unsafe-return": {"unsafeReturn": 1}}}}`,
+	}), tspath.ResolvePath(rootDir, "baseline.json"))
+	assert.NilError(t, err)
+
+	tracker := NewTracker(baseline, rootDir, true)
+	assert.Assert(t, !tracker.Add(diagnostic(file, "no-unsafe-call", "unsafeCall")))
+	assert.Assert(t, !tracker.Add(diagnostic(file, "no-unsafe-call", "unsafeCall")))
+	assert.Assert(t, tracker.Add(diagnostic(file, "no-unsafe-call", "unsafeCall")))
+	assert.Assert(t, tracker.Add(diagnostic(file, "no-unsafe-call", "unsafeNew")))
+
+	assert.DeepEqual(t, tracker.Current().Files, Counts{
+		"file.ts": {"no-unsafe-call": {"unsafeCall": 3, "unsafeNew": 1}},
+	})
+	assert.DeepEqual(t, tracker.Pruned().Files, Counts{
+		"file.ts": {"no-unsafe-call": {"unsafeCall": 2}},
+	})
+
+	data, err := tracker.Pruned().Marshal()
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), `{
+  "files": {
+    "file.ts": {
+      "no-unsafe-call": {
+        "unsafeCall": 2
+      }
+    }
+  }
+}
+`)
+}
+
+func TestTrackerWithoutBaseline(t *testing.T) {
+	file := testSourceFile(t)
+
+	tracker := NewTracker(nil, fixtures.GetRootDir(), true)
+	assert.Assert(t, tracker.Add(diagnostic(file, "no-unsafe-call", "unsafeCall")))
+	assert.DeepEqual(t, tracker.Pruned().Files, Counts{})
+}
+
+func TestTrackerAddAll(t *testing.T) {
+	file := testSourceFile(t)
+	rootDir := fixtures.GetRootDir()
+
+	baseline := &Baseline{Files: Counts{"file.ts": {"no-unsafe-call": {"unsafeCall": 1}}}}
+	at := func(pos int) rule.RuleDiagnostic {
+		d := diagnostic(file, "no-unsafe-call", "unsafeCall")
+		d.Range = core.NewTextRange(pos, pos+1)
+		return d
+	}
+	// the problems after the first ones of a kind are new, whatever order
+	// they are found in
+	for _, diagnostics := range [][]rule.RuleDiagnostic{{at(0), at(6), at(10)}, {at(10), at(0), at(6)}, {at(6), at(10), at(0)}} {
+		newDiagnostics := NewTracker(baseline, rootDir, true).AddAll(diagnostics)
+		assert.DeepEqual(t, utils.Map(newDiagnostics, func(d rule.RuleDiagnostic) int { return d.Range.Pos() }), []int{6, 10})
+	}
+}
@@ -0,0 +1,171 @@
+// Package cache stores the problems of linted files on disk, so that files
+// whose lint results can't have changed aren't linted again.
+package cache
+
+import (
+	"encoding/json"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/microsoft/typescript-go/shim/vfs"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+)
+
+type Fix struct {
+	Text string `json:"text"`
+	Pos  int    `json:"pos"`
+	End  int    `json:"end"`
+}
+
+type Suggestion struct {
+	MessageId string `json:"messageId"`
+	Message   string `json:"message"`
+	Fixes     []Fix  `json:"fixes"`
+}
+
+type Diagnostic struct {
+	Rule        string                  `json:"rule"`
+	Severity    rule.DiagnosticSeverity `json:"severity"`
+	Pos         int                     `json:"pos"`
+	End         int                     `json:"end"`
+	MessageId   string                  `json:"messageId"`
+	Message     string                  `json:"message"`
+	Fixes       *[]Fix                  `json:"fixes,omitempty"`
+	Suggestions *[]Suggestion           `json:"suggestions,omitempty"`
+}
+
+type Entry struct {
+	// from FileKeys
+	Key         string       `json:"key"`
+	Diagnostics []Diagnostic `json:"diagnostics"`
+}
+
+// Cache holds the problems of files by their name relative to the cache file.
+// It isn't safe for concurrent use.
+type Cache struct {
+	Files map[string]Entry `json:"files"`
+
+	comparePathOptions tspath.ComparePathsOptions
+}
+
+// Load reads the cache file. A cache that is missing or can't be decoded,
+// e.g. because it was written by another version, is empty.
+func Load(fs vfs.FS, fileName string) *Cache {
+	c := &Cache{
+		comparePathOptions: tspath.ComparePathsOptions{
+			CurrentDirectory:          tspath.GetDirectoryPath(fileName),
+			UseCaseSensitiveFileNames: fs.UseCaseSensitiveFileNames(),
+		},
+	}
+	if text, ok := fs.ReadFile(fileName); ok {
+		if err := utils.DecodeJSONStrict([]byte(text), c); err != nil {
+			c.Files = nil
+		}
+	}
+	if c.Files == nil {
+		c.Files = map[string]Entry{}
+	}
+	return c
+}
+
+func (c *Cache) Marshal() ([]byte, error) {
+	return json.Marshal(c)
+}
+
+func (c *Cache) relativeFileName(fileName string) string {
+	return tspath.ConvertToRelativePath(fileName, c.comparePathOptions)
+}
+
+func convertFixes(fixes []rule.RuleFix) []Fix {
+	converted := make([]Fix, len(fixes))
+	for i, fix := range fixes {
+		converted[i] = Fix{fix.Text, fix.Range.Pos(), fix.Range.End()}
+	}
+	return converted
+}
+
+func restoreFixes(fixes []Fix) []rule.RuleFix {
+	restored := make([]rule.RuleFix, len(fixes))
+	for i, fix := range fixes {
+		restored[i] = rule.RuleFix{Text: fix.Text, Range: core.NewTextRange(fix.Pos, fix.End)}
+	}
+	return restored
+}
+
+// Get returns the problems of file recorded with key, if there are any.
+func (c *Cache) Get(file *ast.SourceFile, key string) ([]rule.RuleDiagnostic, bool) {
+	entry, ok := c.Files[c.relativeFileName(file.FileName())]
+	if !ok || entry.Key != key {
+		return nil, false
+	}
+	diagnostics := make([]rule.RuleDiagnostic, len(entry.Diagnostics))
+	for i, d := range entry.Diagnostics {
+		diagnostics[i] = rule.RuleDiagnostic{
+			Range:    core.NewTextRange(d.Pos, d.End),
+			RuleName: d.Rule,
+			Severity: d.Severity,
+			Message: rule.RuleMessage{
+				Id:          d.MessageId,
+				Description: d.Message,
+			},
+			SourceFile: file,
+		}
+		if d.Fixes != nil {
+			fixes := restoreFixes(*d.Fixes)
+			diagnostics[i].FixesPtr = &fixes
+		}
+		if d.Suggestions != nil {
+			suggestions := make([]rule.RuleSuggestion, len(*d.Suggestions))
+			for j, s := range *d.Suggestions {
+				suggestions[j] = rule.RuleSuggestion{
+					Message:  rule.RuleMessage{Id: s.MessageId, Description: s.Message},
+					FixesArr: restoreFixes(s.Fixes),
+				}
+			}
+			diagnostics[i].Suggestions = &suggestions
+		}
+	}
+	return diagnostics, true
+}
+
+// Set records the problems of fileName, which may be none, under key.
+func (c *Cache) Set(fileName string, key string, diagnostics []rule.RuleDiagnostic) {
+	entry := Entry{Key: key, Diagnostics: make([]Diagnostic, len(diagnostics))}
+	for i, d := range diagnostics {
+		entry.Diagnostics[i] = Diagnostic{
+			Rule:      d.RuleName,
+			Severity:  d.Severity,
+			Pos:       d.Range.Pos(),
+			End:       d.Range.End(),
+			MessageId: d.Message.Id,
+			Message:   d.Message.Description,
+		}
+		if d.FixesPtr != nil {
+			fixes := convertFixes(*d.FixesPtr)
+			entry.Diagnostics[i].Fixes = &fixes
+		}
+		if d.Suggestions != nil {
+			suggestions := make([]Suggestion, len(*d.Suggestions))
+			for j, s := range *d.Suggestions {
+				suggestions[j] = Suggestion{
+					MessageId: s.Message.Id,
+					Message:   s.Message.Description,
+					Fixes:     convertFixes(s.FixesArr),
+				}
+			}
+			entry.Diagnostics[i].Suggestions = &suggestions
+		}
+	}
+	c.Files[c.relativeFileName(fileName)] = entry
+}
+
+// Prune drops the entries of files that no longer exist.
+func (c *Cache) Prune(fs vfs.FS) {
+	for fileName := range c.Files {
+		if !fs.FileExists(tspath.ResolvePath(c.comparePathOptions.CurrentDirectory, fileName)) {
+			delete(c.Files, fileName)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package cache
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/compiler"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/rules/fixtures"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+	"gotest.tools/v3/assert"
+)
+
+// newProgram creates a program of files, by their names relative to the
+// fixtures, and returns it along with their source files.
+func newProgram(t *testing.T, files map[string]string) (*compiler.Program, []*ast.SourceFile) {
+	t.Helper()
+
+	rootDir := fixtures.GetRootDir()
+	virtualFiles := map[string]string{}
+	for name, text := range files {
+		virtualFiles[tspath.ResolvePath(rootDir, name)] = text
+	}
+	fs := utils.NewOverlayVFS(utils.NewOverlayVFSForFile(tspath.ResolvePath(rootDir, "file.ts"), "export {};\n"), virtualFiles)
+	program, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.json", utils.CreateCompilerHost(rootDir, fs))
+	assert.NilError(t, err)
+
+	var sourceFiles []*ast.SourceFile
+	for name := range files {
+		sourceFiles = append(sourceFiles, program.GetSourceFile(tspath.ResolvePath(rootDir, name)))
+	}
+	return program, sourceFiles
+}
+
+func getKeys(t *testing.T, files map[string]string, salt string) map[string]string {
+	t.Helper()
+
+	program, sourceFiles := newProgram(t, files)
+	keys := map[string]string{}
+	for path, key := range FileKeys(program, sourceFiles, salt) {
+		keys[tspath.GetBaseFileName(string(path))] = key
+	}
+	return keys
+}
+
+func TestFileKeys(t *testing.T) {
+	files := map[string]string{
+		"importer.ts":   "import { b } from './imported';\nexport const a = b;\n",
+		"imported.ts":   "import { c } from './transitive';\nexport const b = c;\n",
+		"transitive.ts": "export const c = 1;\n",
+		"unrelated.ts":  "export const d = 1;\n",
+		"globals.d.ts":  "declare const e: number;\n",
+	}
+	keys := getKeys(t, files, "salt")
+
+	files["transitive.ts"] = "export const c = '1';\n"
+	changedImport := getKeys(t, files, "salt")
+	assert.Assert(t, changedImport["importer.ts"] != keys["importer.ts"])
+	assert.Assert(t, changedImport["imported.ts"] != keys["imported.ts"])
+	assert.Assert(t, changedImport["transitive.ts"] != keys["transitive.ts"])
+	assert.Equal(t, changedImport["unrelated.ts"], keys["unrelated.ts"])
+
+	files["globals.d.ts"] = "declare const e: string;\n"
+	changedGlobals := getKeys(t, files, "salt")
+	assert.Assert(t, changedGlobals["unrelated.ts"] != changedImport["unrelated.ts"])
+
+	changedSalt := getKeys(t, files, "other salt")
+	assert.Assert(t, changedSalt["unrelated.ts"] != changedGlobals["unrelated.ts"])
+}
+
+func TestUnaffectedFiles(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	files := map[string]string{
+		"importer.ts":   "import { b } from './imported';\nexport const a = b;\n",
+		"imported.ts":   "import { c } from './transitive';\nexport const b = c;\n",
+		"transitive.ts": "export const c = 1;\n",
+		"unrelated.ts":  "export const d = 1;\n",
+		"globals.d.ts":  "declare const e: number;\n",
+	}
+	program, sourceFiles := newProgram(t, files)
+	unaffected := func(changed ...string) []string {
+		for i, name := range changed {
+			changed[i] = tspath.ResolvePath(rootDir, name)
+		}
+		names := utils.Map(UnaffectedFiles(program, sourceFiles, changed), func(file *ast.SourceFile) string {
+			return tspath.GetBaseFileName(file.FileName())
+		})
+		slices.Sort(names)
+		return names
+	}
+
+	assert.DeepEqual(t, unaffected(), []string{"globals.d.ts", "imported.ts", "importer.ts", "transitive.ts", "unrelated.ts"})
+	// files importing a fixed file are linted against its fixed text
+	assert.DeepEqual(t, unaffected("transitive.ts"), []string{"globals.d.ts", "unrelated.ts"})
+	assert.DeepEqual(t, unaffected("importer.ts", "unrelated.ts"), []string{"globals.d.ts", "imported.ts", "transitive.ts"})
+	assert.Equal(t, len(unaffected("globals.d.ts")), 0)
+}
+
+func TestGetAndSet(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	fileName := tspath.ResolvePath(rootDir, "file.ts")
+	fs := utils.NewOverlayVFSForFile(fileName, "const a = 1;\n")
+	program, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.json", utils.CreateCompilerHost(rootDir, fs))
+	assert.NilError(t, err)
+	file := program.GetSourceFile(fileName)
+
+	fixes := []rule.RuleFix{{Text: "b", Range: core.NewTextRange(6, 7)}}
+	suggestions := []rule.RuleSuggestion{{
+		Message:  rule.RuleMessage{Id: "rename", Description: "Rename."},
+		FixesArr: []rule.RuleFix{{Text: "c", Range: core.NewTextRange(6, 7)}},
+	}}
+	diagnostics := []rule.RuleDiagnostic{
+		{
+			Range:      core.NewTextRange(6, 7),
+			RuleName:   "some-rule",
+			Severity:   rule.SeverityWarning,
+			Message:    rule.RuleMessage{Id: "some", Description: "Some."},
+			FixesPtr:   &fixes,
+			SourceFile: file,
+		},
+		{
+			Range:       core.NewTextRange(10, 11),
+			RuleName:    "other-rule",
+			Message:     rule.RuleMessage{Id: "other", Description: "Other."},
+			Suggestions: &suggestions,
+			SourceFile:  file,
+		},
+	}
+
+	cacheFileName := tspath.ResolvePath(rootDir, ".tsgolintcache")
+	c := Load(fs, cacheFileName)
+	c.Set(fileName, "key", diagnostics)
+	data, err := c.Marshal()
+	assert.NilError(t, err)
+
+	loaded := Load(utils.NewOverlayVFS(fs, map[string]string{cacheFileName: string(data)}), cacheFileName)
+	assert.DeepEqual(t, loaded.Files, c.Files)
+	_, ok := loaded.Get(file, "other key")
+	assert.Assert(t, !ok)
+	cached, ok := loaded.Get(file, "key")
+	assert.Assert(t, ok)
+	assert.Equal(t, len(cached), len(diagnostics))
+	for i, d := range cached {
+		assert.Equal(t, d.Range, diagnostics[i].Range)
+		assert.Equal(t, d.RuleName, diagnostics[i].RuleName)
+		assert.Equal(t, d.Severity, diagnostics[i].Severity)
+		assert.Equal(t, d.Message, diagnostics[i].Message)
+		assert.Equal(t, d.SourceFile, file)
+	}
+	assert.Assert(t, slices.Equal(*cached[0].FixesPtr, fixes))
+	assert.Assert(t, cached[0].Suggestions == nil)
+	assert.Assert(t, cached[1].FixesPtr == nil)
+	assert.Equal(t, len(*cached[1].Suggestions), 1)
+	assert.Equal(t, (*cached[1].Suggestions)[0].Message, suggestions[0].Message)
+	assert.Assert(t, slices.Equal((*cached[1].Suggestions)[0].FixesArr, suggestions[0].FixesArr))
+}
+
+func TestLoadInvalid(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	cacheFileName := tspath.ResolvePath(rootDir, ".tsgolintcache")
+	c := Load(utils.NewOverlayVFSForFile(cacheFileName, `{"unknown": true}`), cacheFileName)
+	assert.Equal(t, len(c.Files), 0)
+}
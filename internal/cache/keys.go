@@ -0,0 +1,126 @@
+package cache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/compiler"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+)
+
+// affectsGlobalScope tells whether a file can change types in files that
+// don't import it: scripts declare globals, and modules can augment other
+// modules or the global scope.
+func affectsGlobalScope(file *ast.SourceFile) bool {
+	if !ast.IsExternalModule(file) || len(file.ModuleAugmentations) > 0 {
+		return true
+	}
+	for _, statement := range file.Statements.Nodes {
+		if ast.IsGlobalScopeAugmentation(statement) {
+			return true
+		}
+	}
+	return false
+}
+
+// FileKeys hashes each of files with everything its lint results depend on:
+// its text, the text of the files it imports, directly or through other
+// files, the text of the files that affect the global scope, and salt, which
+// stands for the configuration and the version of the linter. The default
+// libraries are left out, since they come with the linter.
+func FileKeys(program *compiler.Program, files []*ast.SourceFile, salt string) map[tspath.Path]string {
+	contentHashes := map[tspath.Path][]byte{}
+	contentHash := func(path tspath.Path) []byte {
+		hash, ok := contentHashes[path]
+		if !ok {
+			sum := sha256.Sum256([]byte(program.GetSourceFileByPath(path).Text()))
+			hash = sum[:]
+			contentHashes[path] = hash
+		}
+		return hash
+	}
+
+	// paths are left out of the keys, so that they don't change when the
+	// project is checked out elsewhere
+	hashAll := func(prefix []byte, hashes [][]byte) []byte {
+		slices.SortFunc(hashes, bytes.Compare)
+		hash := sha256.New()
+		hash.Write(prefix)
+		for _, h := range hashes {
+			hash.Write(h)
+		}
+		return hash.Sum(nil)
+	}
+
+	var globalHashes [][]byte
+	for _, file := range program.SourceFiles() {
+		if !program.IsSourceFileDefaultLibrary(file.Path()) && affectsGlobalScope(file) {
+			globalHashes = append(globalHashes, contentHash(file.Path()))
+		}
+	}
+	global := hashAll([]byte(salt), globalHashes)
+
+	imports := utils.GetImportedFiles(program)
+	keys := make(map[tspath.Path]string, len(files))
+	for _, file := range files {
+		seen := map[tspath.Path]struct{}{file.Path(): {}}
+		queue := []tspath.Path{file.Path()}
+		for len(queue) > 0 {
+			path := queue[0]
+			queue = queue[1:]
+			for _, imported := range imports[path] {
+				if _, ok := seen[imported]; !ok && !program.IsSourceFileDefaultLibrary(imported) {
+					seen[imported] = struct{}{}
+					queue = append(queue, imported)
+				}
+			}
+		}
+		hashes := make([][]byte, 0, len(seen))
+		// the file itself goes first, so that it can't swap places with an
+		// import with the same text
+		for path := range seen {
+			if path != file.Path() {
+				hashes = append(hashes, contentHash(path))
+			}
+		}
+		keys[file.Path()] = hex.EncodeToString(hashAll(slices.Concat(global, contentHash(file.Path())), hashes))
+	}
+	return keys
+}
+
+// UnaffectedFiles returns the files whose keys don't depend on the changed
+// files: those that neither changed nor import a changed file, directly or
+// through other files. A change of a file that affects the global scope
+// affects all files.
+func UnaffectedFiles(program *compiler.Program, files []*ast.SourceFile, changedFileNames []string) []*ast.SourceFile {
+	var changed []*ast.SourceFile
+	for _, fileName := range changedFileNames {
+		file := program.GetSourceFile(fileName)
+		if file == nil {
+			continue
+		}
+		if affectsGlobalScope(file) {
+			return nil
+		}
+		changed = append(changed, file)
+	}
+	if len(changed) == 0 {
+		return files
+	}
+
+	affected := map[tspath.Path]struct{}{}
+	for _, file := range utils.GetDependents(program, changed) {
+		affected[file.Path()] = struct{}{}
+	}
+	unaffected := make([]*ast.SourceFile, 0, len(files))
+	for _, file := range files {
+		if _, ok := affected[file.Path()]; !ok {
+			unaffected = append(unaffected, file)
+		}
+	}
+	return unaffected
+}
@@ -0,0 +1,334 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/microsoft/typescript-go/shim/vfs"
+	"github.com/typescript-eslint/tsgolint/internal/linter"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+)
+
+const ConfigFileName = "tsgolint.json"
+
+type Config struct {
+	Schema    string `json:"$schema"`
+	Rules     map[string]RuleConfig
+	Overrides []Override
+}
+
+// Override changes the rules of the files matching any of its globs, which
+// are relative to the config file. Later overrides win over earlier ones.
+type Override struct {
+	Files []string
+	Rules map[string]RuleConfig
+}
+
+type RuleConfig struct {
+	Enabled  bool
+	Severity rule.DiagnosticSeverity
+	// nil if the rule is configured without options
+	Options json.RawMessage
+}
+
+// UnmarshalJSON accepts, like ESLint:
+//   - a severity: "error"/2, "warn"/1 or "off"/0, or true/false as "error"/"off"
+//   - an object of options, which turns the rule on as an error
+//   - an array of a severity and optionally options: ["warn", {...}]
+func (c *RuleConfig) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '{' {
+		c.Enabled = true
+		c.Severity = rule.SeverityError
+		c.Options = slices.Clone(data)
+		return nil
+	}
+	if len(data) > 0 && data[0] == '[' {
+		var elements []json.RawMessage
+		if err := json.Unmarshal(data, &elements); err != nil {
+			return err
+		}
+		if len(elements) == 0 || len(elements) > 2 {
+			return fmt.Errorf("expected an array of a severity and optionally rule options, got %s", data)
+		}
+		if err := c.unmarshalSeverity(bytes.TrimSpace(elements[0])); err != nil {
+			return err
+		}
+		if len(elements) == 2 {
+			c.Options = slices.Clone(elements[1])
+		}
+		return nil
+	}
+	return c.unmarshalSeverity(data)
+}
+
+func (c *RuleConfig) unmarshalSeverity(data []byte) error {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	switch value {
+	case "error", 2.0, true:
+		c.Enabled = true
+		c.Severity = rule.SeverityError
+	case "warn", 1.0:
+		c.Enabled = true
+		c.Severity = rule.SeverityWarning
+	case "off", 0.0, false:
+		c.Enabled = false
+	default:
+		return fmt.Errorf(`expected a severity ("error", "warn" or "off"), an object of rule options or an array of both, got %s`, data)
+	}
+	return nil
+}
+
+// FindConfigFile returns the path of tsgolint.json in dir, or "" if there is none.
+func FindConfigFile(fs vfs.FS, dir string) string {
+	configPath := tspath.CombinePaths(dir, ConfigFileName)
+	if fs.FileExists(configPath) {
+		return configPath
+	}
+	return ""
+}
+
+func LoadConfigFile(fs vfs.FS, configPath string) (*Config, error) {
+	text, ok := fs.ReadFile(configPath)
+	if !ok {
+		return nil, fmt.Errorf("couldn't read config at %v", configPath)
+	}
+	return ParseConfig([]byte(text))
+}
+
+func ParseConfig(data []byte) (*Config, error) {
+	var config Config
+	if err := utils.DecodeJSONStrict(data, &config); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// ConfigureRules resolves which rules are enabled and decodes their options.
+// A nil config enables every rule with its default options. All problems are
+// collected and returned together, so they can be fixed in one go.
+func ConfigureRules(config *Config, rules []rule.Rule) ([]linter.ConfiguredRule, error) {
+	if config == nil {
+		return utils.Map(rules, func(r rule.Rule) linter.ConfiguredRule {
+			return configureRule(r, rule.SeverityError, nil)
+		}), nil
+	}
+	return configureRules(config.Rules, rules)
+}
+
+func configureRule(r rule.Rule, severity rule.DiagnosticSeverity, options any) linter.ConfiguredRule {
+	return linter.ConfiguredRule{
+		Name:     r.Name,
+		Severity: severity,
+		Run: func(ctx rule.RuleContext) rule.RuleListeners {
+			return r.Run(ctx, options)
+		},
+	}
+}
+
+func configureRules(ruleConfigs map[string]RuleConfig, rules []rule.Rule) ([]linter.ConfiguredRule, error) {
+	var errs []error
+
+	ruleNames := make([]string, 0, len(ruleConfigs))
+	for name := range ruleConfigs {
+		ruleNames = append(ruleNames, name)
+	}
+	slices.Sort(ruleNames)
+	for _, name := range ruleNames {
+		if !slices.ContainsFunc(rules, func(r rule.Rule) bool { return r.Name == name }) {
+			errs = append(errs, fmt.Errorf("unknown rule %q", name))
+		}
+	}
+
+	configured := []linter.ConfiguredRule{}
+	for _, r := range rules {
+		ruleConfig, ok := ruleConfigs[r.Name]
+		if !ok {
+			continue
+		}
+
+		var options any
+		if ruleConfig.Options != nil {
+			if !registry.AcceptsOptions(r) {
+				errs = append(errs, fmt.Errorf("rule %q doesn't accept options", r.Name))
+				continue
+			}
+			var err error
+			options, err = registry.DecodeOptions(r, ruleConfig.Options)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("rule %q: invalid options: %w", r.Name, err))
+				continue
+			}
+		}
+		// the options of disabled rules are checked too, since an override
+		// that only turns the rule on keeps them
+		if !ruleConfig.Enabled {
+			continue
+		}
+
+		configured = append(configured, configureRule(r, ruleConfig.Severity, options))
+	}
+
+	if len(errs) != 0 {
+		return nil, errors.Join(errs...)
+	}
+	return configured, nil
+}
+
+// mergeRuleConfigs applies the rule configs of an override on top of others.
+// Like in ESLint, a rule that is only given a severity keeps its options.
+func mergeRuleConfigs(base map[string]RuleConfig, override map[string]RuleConfig) map[string]RuleConfig {
+	merged := maps.Clone(base)
+	if merged == nil {
+		merged = map[string]RuleConfig{}
+	}
+	for name, ruleConfig := range override {
+		if ruleConfig.Options == nil {
+			ruleConfig.Options = merged[name].Options
+		}
+		merged[name] = ruleConfig
+	}
+	return merged
+}
+
+type compiledOverride struct {
+	files []*utils.Glob
+	rules map[string]RuleConfig
+}
+
+// RuleSet resolves the rules of each file from a config and its overrides.
+// Files matching the same overrides share their configured rules.
+type RuleSet struct {
+	rules              []rule.Rule
+	baseRules          map[string]RuleConfig
+	baseConfigured     []linter.ConfiguredRule
+	overrides          []compiledOverride
+	comparePathOptions tspath.ComparePathsOptions
+	enabledRuleNames   []string
+
+	mu sync.Mutex
+	// by the indices of the matching overrides
+	cache map[string][]linter.ConfiguredRule
+}
+
+// NewRuleSet checks the config and all of its overrides up front, so that
+// every problem is reported before linting starts. Since options are checked
+// even for disabled rules, and any rule config of a file comes from the config
+// or one of the overrides, files matching several overrides can't get invalid
+// options. configDirectory is what the globs of overrides are relative to.
+func NewRuleSet(config *Config, rules []rule.Rule, configDirectory string, useCaseSensitiveFileNames bool) (*RuleSet, error) {
+	baseConfigured, err := ConfigureRules(config, rules)
+	if err != nil {
+		return nil, err
+	}
+	set := &RuleSet{
+		rules:          rules,
+		baseConfigured: baseConfigured,
+		comparePathOptions: tspath.ComparePathsOptions{
+			CurrentDirectory:          configDirectory,
+			UseCaseSensitiveFileNames: useCaseSensitiveFileNames,
+		},
+		cache: map[string][]linter.ConfiguredRule{},
+	}
+
+	enabled := utils.Set[string]{}
+	for _, r := range baseConfigured {
+		enabled.Add(r.Name)
+	}
+
+	if config != nil {
+		set.baseRules = config.Rules
+		var errs []error
+		for i, override := range config.Overrides {
+			if len(override.Files) == 0 {
+				errs = append(errs, fmt.Errorf("overrides[%v]: no files", i))
+				continue
+			}
+			compiled := compiledOverride{rules: override.Rules}
+			for _, pattern := range override.Files {
+				glob, err := utils.CompileGlob(pattern, useCaseSensitiveFileNames)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("overrides[%v]: %w", i, err))
+					continue
+				}
+				compiled.files = append(compiled.files, glob)
+			}
+			configured, err := configureRules(mergeRuleConfigs(config.Rules, override.Rules), rules)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("overrides[%v]: %w", i, err))
+				continue
+			}
+			for _, r := range configured {
+				enabled.Add(r.Name)
+			}
+			set.overrides = append(set.overrides, compiled)
+		}
+		if len(errs) != 0 {
+			return nil, errors.Join(errs...)
+		}
+	}
+
+	for _, r := range rules {
+		if enabled.Has(r.Name) {
+			set.enabledRuleNames = append(set.enabledRuleNames, r.Name)
+		}
+	}
+	return set, nil
+}
+
+// EnabledRuleNames lists the rules enabled for any file, in the order of the
+// rules the set was created with.
+func (s *RuleSet) EnabledRuleNames() []string {
+	return s.enabledRuleNames
+}
+
+// RulesForFile is safe to call from multiple goroutines.
+func (s *RuleSet) RulesForFile(fileName string) ([]linter.ConfiguredRule, error) {
+	if len(s.overrides) == 0 {
+		return s.baseConfigured, nil
+	}
+
+	relativeFileName := tspath.ConvertToRelativePath(fileName, s.comparePathOptions)
+	var matching []int
+	var key strings.Builder
+	for i, override := range s.overrides {
+		if slices.ContainsFunc(override.files, func(glob *utils.Glob) bool { return glob.Match(relativeFileName) }) {
+			matching = append(matching, i)
+			key.WriteString(strconv.Itoa(i))
+			key.WriteByte(',')
+		}
+	}
+	if len(matching) == 0 {
+		return s.baseConfigured, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if configured, ok := s.cache[key.String()]; ok {
+		return configured, nil
+	}
+
+	ruleConfigs := s.baseRules
+	for _, i := range matching {
+		ruleConfigs = mergeRuleConfigs(ruleConfigs, s.overrides[i].rules)
+	}
+	configured, err := configureRules(ruleConfigs, s.rules)
+	if err != nil {
+		return nil, fmt.Errorf("rules of %v: %w", relativeFileName, err)
+	}
+	s.cache[key.String()] = configured
+	return configured, nil
+}
@@ -0,0 +1,282 @@
+package config
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/linter"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/rules/no_floating_promises"
+	"github.com/typescript-eslint/tsgolint/internal/rules/no_misused_promises"
+	"github.com/typescript-eslint/tsgolint/internal/rules/return_await"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+	"gotest.tools/v3/assert"
+)
+
+func TestConfigureRules(t *testing.T) {
+	var decodedOptions any
+	rules := []rule.Rule{
+		{
+			Name: "no-options",
+			Run:  func(ctx rule.RuleContext, options any) rule.RuleListeners { return nil },
+		},
+		{
+			Name: "no-floating-promises",
+			Meta: no_floating_promises.NoFloatingPromisesRule.Meta,
+			Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+				decodedOptions = options
+				return nil
+			},
+		},
+	}
+
+	t.Run("no config enables all rules", func(t *testing.T) {
+		configured, err := ConfigureRules(nil, rules)
+		assert.NilError(t, err)
+		assert.Equal(t, len(configured), 2)
+	})
+
+	t.Run("only enabled rules are configured", func(t *testing.T) {
+		config, err := ParseConfig([]byte(`{"rules": {"no-options": false, "no-floating-promises": true}}`))
+		assert.NilError(t, err)
+		configured, err := ConfigureRules(config, rules)
+		assert.NilError(t, err)
+		assert.Equal(t, len(configured), 1)
+		assert.Equal(t, configured[0].Name, "no-floating-promises")
+	})
+
+	t.Run("severity is taken from the config", func(t *testing.T) {
+		config, err := ParseConfig([]byte(`{"rules": {"no-options": "warn", "no-floating-promises": ["error", {"ignoreVoid": true}]}}`))
+		assert.NilError(t, err)
+		configured, err := ConfigureRules(config, rules)
+		assert.NilError(t, err)
+		assert.Equal(t, len(configured), 2)
+		assert.Equal(t, configured[0].Severity, rule.SeverityWarning)
+		assert.Equal(t, configured[1].Severity, rule.SeverityError)
+	})
+
+	t.Run("options are decoded into the options struct", func(t *testing.T) {
+		config, err := ParseConfig([]byte(`{
+			"rules": {
+				"no-floating-promises": {
+					"ignoreVoid": false,
+					"allowForKnownSafeCalls": [{"from": "package", "name": ["foo", "bar"], "package": "baz"}]
+				}
+			}
+		}`))
+		assert.NilError(t, err)
+		configured, err := ConfigureRules(config, rules)
+		assert.NilError(t, err)
+		configured[0].Run(rule.RuleContext{})
+
+		opts, ok := decodedOptions.(no_floating_promises.NoFloatingPromisesOptions)
+		assert.Assert(t, ok, "options have type %T", decodedOptions)
+		assert.DeepEqual(t, opts, no_floating_promises.NoFloatingPromisesOptions{
+			IgnoreVoid: utils.Ref(false),
+			AllowForKnownSafeCalls: []utils.TypeOrValueSpecifier{{
+				From:    utils.TypeOrValueSpecifierFromPackage,
+				Name:    []string{"foo", "bar"},
+				Package: "baz",
+			}},
+		})
+	})
+
+	t.Run("all problems are reported", func(t *testing.T) {
+		config, err := ParseConfig([]byte(`{
+			"rules": {
+				"unknown-rule": true,
+				"no-options": {"foo": true},
+				"no-floating-promises": {"ignoreVoid": "yes"}
+			}
+		}`))
+		assert.NilError(t, err)
+		_, err = ConfigureRules(config, rules)
+		assert.ErrorContains(t, err, `unknown rule "unknown-rule"`)
+		assert.ErrorContains(t, err, `rule "no-options" doesn't accept options`)
+		assert.ErrorContains(t, err, `rule "no-floating-promises": invalid options`)
+	})
+
+	t.Run("options of disabled rules are checked", func(t *testing.T) {
+		config, err := ParseConfig([]byte(`{"rules": {"no-floating-promises": ["off", {"bogus": 1}]}}`))
+		assert.NilError(t, err)
+		_, err = ConfigureRules(config, rules)
+		assert.ErrorContains(t, err, `unknown field "bogus"`)
+	})
+
+	t.Run("unknown option fields are rejected", func(t *testing.T) {
+		config, err := ParseConfig([]byte(`{"rules": {"no-floating-promises": {"ignoreVoids": true}}}`))
+		assert.NilError(t, err)
+		_, err = ConfigureRules(config, rules)
+		assert.ErrorContains(t, err, `unknown field "ignoreVoids"`)
+	})
+}
+
+func TestRuleConfigUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		json     string
+		expected RuleConfig
+	}{
+		{`true`, RuleConfig{Enabled: true, Severity: rule.SeverityError}},
+		{`false`, RuleConfig{Enabled: false}},
+		{`"error"`, RuleConfig{Enabled: true, Severity: rule.SeverityError}},
+		{`"warn"`, RuleConfig{Enabled: true, Severity: rule.SeverityWarning}},
+		{`"off"`, RuleConfig{Enabled: false}},
+		{`2`, RuleConfig{Enabled: true, Severity: rule.SeverityError}},
+		{`1`, RuleConfig{Enabled: true, Severity: rule.SeverityWarning}},
+		{`0`, RuleConfig{Enabled: false}},
+		{`{"a": 1}`, RuleConfig{Enabled: true, Severity: rule.SeverityError, Options: []byte(`{"a": 1}`)}},
+		{`["warn"]`, RuleConfig{Enabled: true, Severity: rule.SeverityWarning}},
+		{`["warn", "never"]`, RuleConfig{Enabled: true, Severity: rule.SeverityWarning, Options: []byte(`"never"`)}},
+	}
+	for _, c := range cases {
+		t.Run(c.json, func(t *testing.T) {
+			var ruleConfig RuleConfig
+			assert.NilError(t, json.Unmarshal([]byte(c.json), &ruleConfig))
+			assert.DeepEqual(t, ruleConfig, c.expected)
+		})
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	for _, code := range []string{
+		`{"rule": {}}`,
+		`{"rules": {"no-floating-promises": 3}}`,
+		`{"rules": {"no-floating-promises": "warning"}}`,
+		`{"rules": {"no-floating-promises": []}}`,
+		`{"rules": {"no-floating-promises": ["warn", {}, {}]}}`,
+		`{"rules": {}} {}`,
+	} {
+		t.Run(code, func(t *testing.T) {
+			_, err := ParseConfig([]byte(code))
+			assert.Assert(t, err != nil)
+		})
+	}
+}
+
+func TestDecodeRuleSpecificOptions(t *testing.T) {
+	t.Run("return-await accepts a bare string", func(t *testing.T) {
+		options, err := registry.DecodeOptions(return_await.ReturnAwaitRule, []byte(`"never"`))
+		assert.NilError(t, err)
+		assert.DeepEqual(t, options, return_await.ReturnAwaitOptions{Option: utils.Ref(return_await.ReturnAwaitOptionNever)})
+
+		_, err = registry.DecodeOptions(return_await.ReturnAwaitRule, []byte(`{"option": "sometimes"}`))
+		assert.ErrorContains(t, err, `unknown return-await option "sometimes"`)
+	})
+
+	t.Run("no-misused-promises accepts checksVoidReturn as a boolean or an object", func(t *testing.T) {
+		options, err := registry.DecodeOptions(no_misused_promises.NoMisusedPromisesRule, []byte(`{"checksVoidReturn": false}`))
+		assert.NilError(t, err)
+		assert.DeepEqual(t, options, no_misused_promises.NoMisusedPromisesOptions{ChecksVoidReturn: utils.Ref(false)})
+
+		options, err = registry.DecodeOptions(no_misused_promises.NoMisusedPromisesRule, []byte(`{"checksVoidReturn": {"arguments": false}}`))
+		assert.NilError(t, err)
+		assert.DeepEqual(t, options, no_misused_promises.NoMisusedPromisesOptions{
+			ChecksVoidReturnOpts: &no_misused_promises.NoMisusedPromisesChecksVoidReturnOptions{Arguments: utils.Ref(false)},
+		})
+
+		_, err = registry.DecodeOptions(no_misused_promises.NoMisusedPromisesRule, []byte(`{"checksVoidReturn": {"argument": false}}`))
+		assert.ErrorContains(t, err, `unknown field "argument"`)
+	})
+}
+
+func TestRuleSet(t *testing.T) {
+	var decodedOptions any
+	rules := []rule.Rule{
+		{
+			Name: "no-options",
+			Run:  func(ctx rule.RuleContext, options any) rule.RuleListeners { return nil },
+		},
+		{
+			Name: "no-floating-promises",
+			Meta: no_floating_promises.NoFloatingPromisesRule.Meta,
+			Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+				decodedOptions = options
+				return nil
+			},
+		},
+	}
+
+	config, err := ParseConfig([]byte(`{
+		"rules": {
+			"no-options": "error",
+			"no-floating-promises": ["error", {"ignoreVoid": false}]
+		},
+		"overrides": [
+			{"files": ["**/*.test.ts"], "rules": {"no-options": "off", "no-floating-promises": "warn"}},
+			{"files": ["scripts/**"], "rules": {"no-floating-promises": "off"}}
+		]
+	}`))
+	assert.NilError(t, err)
+	set, err := NewRuleSet(config, rules, "/project", true)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, set.EnabledRuleNames(), []string{"no-options", "no-floating-promises"})
+
+	names := func(configured []linter.ConfiguredRule) []string {
+		return utils.Map(configured, func(r linter.ConfiguredRule) string { return r.Name })
+	}
+	rulesForFile := func(fileName string) []linter.ConfiguredRule {
+		configured, err := set.RulesForFile(fileName)
+		assert.NilError(t, err)
+		return configured
+	}
+
+	assert.DeepEqual(t, names(rulesForFile("/project/src/a.ts")), []string{"no-options", "no-floating-promises"})
+	assert.DeepEqual(t, names(rulesForFile("/project/scripts/build.ts")), []string{"no-options"})
+	assert.Equal(t, len(rulesForFile("/project/scripts/build.test.ts")), 0)
+
+	testRules := rulesForFile("/project/src/a.test.ts")
+	assert.DeepEqual(t, names(testRules), []string{"no-floating-promises"})
+	assert.Equal(t, testRules[0].Severity, rule.SeverityWarning)
+	// a severity alone keeps the options of the base config
+	testRules[0].Run(rule.RuleContext{})
+	assert.DeepEqual(t, decodedOptions, no_floating_promises.NoFloatingPromisesOptions{IgnoreVoid: utils.Ref(false)})
+
+	// files matching the same overrides share their rules
+	assert.Equal(t, &rulesForFile("/project/src/b.test.ts")[0], &testRules[0])
+
+	t.Run("problems in overrides are reported", func(t *testing.T) {
+		config, err := ParseConfig([]byte(`{
+			"overrides": [
+				{"files": [], "rules": {}},
+				{"files": ["src/{a,b"], "rules": {"unknown-rule": "warn"}}
+			]
+		}`))
+		assert.NilError(t, err)
+		_, err = NewRuleSet(config, rules, "/project", true)
+		assert.ErrorContains(t, err, `overrides[0]: no files`)
+		assert.ErrorContains(t, err, `overrides[1]: invalid glob "src/{a,b"`)
+		assert.ErrorContains(t, err, `overrides[1]: unknown rule "unknown-rule"`)
+	})
+
+	t.Run("options disabled in one override are checked for files matching later ones", func(t *testing.T) {
+		config, err := ParseConfig([]byte(`{
+			"overrides": [
+				{"files": ["a/**"], "rules": {"no-floating-promises": ["off", {"bogus": 1}]}},
+				{"files": ["**/x.ts"], "rules": {"no-floating-promises": "warn"}}
+			]
+		}`))
+		assert.NilError(t, err)
+		_, err = NewRuleSet(config, rules, "/project", true)
+		assert.ErrorContains(t, err, `overrides[0]: rule "no-floating-promises": invalid options: `)
+		assert.ErrorContains(t, err, `unknown field "bogus"`)
+	})
+
+	t.Run("rules that can't be configured are an error", func(t *testing.T) {
+		glob, err := utils.CompileGlob("**", true)
+		assert.NilError(t, err)
+		// NewRuleSet doesn't let this through
+		set := &RuleSet{
+			rules: rules,
+			overrides: []compiledOverride{{
+				files: []*utils.Glob{glob},
+				rules: map[string]RuleConfig{"no-options": {Enabled: true, Options: []byte(`{}`)}},
+			}},
+			comparePathOptions: tspath.ComparePathsOptions{CurrentDirectory: "/project"},
+			cache:              map[string][]linter.ConfiguredRule{},
+		}
+		_, err = set.RulesForFile("/project/src/a.ts")
+		assert.Error(t, err, `rules of src/a.ts: rule "no-options" doesn't accept options`)
+	})
+}
@@ -0,0 +1,248 @@
+// Package diff computes line-based differences between two versions of a file.
+package diff
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Lines of context around changes in unified diffs
+const contextLines = 3
+
+type opKind uint8
+
+const (
+	opEqual opKind = iota
+	opDelete
+	opInsert
+)
+
+type op struct {
+	kind opKind
+	// the line in the old text for opEqual and opDelete, in the new text for opInsert
+	line string
+}
+
+// Edit replaces the bytes [Start, End) of the old text with Text.
+type Edit struct {
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+	Text  string `json:"text"`
+}
+
+// Edits returns the changed lines between oldText and newText as
+// non-overlapping edits of oldText, sorted by position.
+func Edits(oldText, newText string) []Edit {
+	edits := []Edit{}
+	offset := 0
+	var current *Edit
+	for _, o := range diffLines(splitLines(oldText), splitLines(newText)) {
+		if o.kind == opEqual {
+			if current != nil {
+				edits = append(edits, *current)
+				current = nil
+			}
+			offset += len(o.line)
+			continue
+		}
+		if current == nil {
+			current = &Edit{Start: offset, End: offset}
+		}
+		if o.kind == opDelete {
+			offset += len(o.line)
+			current.End = offset
+		} else {
+			current.Text += o.line
+		}
+	}
+	if current != nil {
+		edits = append(edits, *current)
+	}
+	return edits
+}
+
+// Unified returns the changes between oldText and newText in the unified diff
+// format, or "" if they are equal.
+func Unified(oldName, newName, oldText, newText string) string {
+	ops := diffLines(splitLines(oldText), splitLines(newText))
+
+	// position of each op in both texts, 0-based
+	oldLines := make([]int, len(ops)+1)
+	newLines := make([]int, len(ops)+1)
+	for i, o := range ops {
+		oldLines[i+1], newLines[i+1] = oldLines[i], newLines[i]
+		if o.kind != opInsert {
+			oldLines[i+1]++
+		}
+		if o.kind != opDelete {
+			newLines[i+1]++
+		}
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(ops); {
+		if ops[i].kind == opEqual {
+			i++
+			continue
+		}
+
+		// extend the hunk until there are more unchanged lines than fit in the
+		// context of two hunks
+		start := max(i-contextLines, 0)
+		end := i
+		for equal := 0; end < len(ops) && equal <= 2*contextLines; end++ {
+			if ops[end].kind == opEqual {
+				equal++
+			} else {
+				equal = 0
+			}
+		}
+		for end > i && ops[end-1].kind == opEqual {
+			end--
+		}
+		end = min(end+contextLines, len(ops))
+
+		if b.Len() == 0 {
+			fmt.Fprintf(&b, "--- %v\n+++ %v\n", oldName, newName)
+		}
+		b.WriteString("@@ -")
+		writeRange(&b, oldLines[start], oldLines[end]-oldLines[start])
+		b.WriteString(" +")
+		writeRange(&b, newLines[start], newLines[end]-newLines[start])
+		b.WriteString(" @@\n")
+		for _, o := range ops[start:end] {
+			switch o.kind {
+			case opEqual:
+				b.WriteByte(' ')
+			case opDelete:
+				b.WriteByte('-')
+			case opInsert:
+				b.WriteByte('+')
+			}
+			b.WriteString(o.line)
+			if !strings.HasSuffix(o.line, "\n") {
+				b.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		i = end
+	}
+	return b.String()
+}
+
+func writeRange(b *strings.Builder, start int, count int) {
+	if count == 0 {
+		fmt.Fprintf(b, "%v,0", start)
+	} else if count == 1 {
+		fmt.Fprintf(b, "%v", start+1)
+	} else {
+		fmt.Fprintf(b, "%v,%v", start+1, count)
+	}
+}
+
+// splitLines splits text after every "\n", keeping the line breaks.
+func splitLines(text string) []string {
+	lines := []string{}
+	for len(text) > 0 {
+		i := strings.IndexByte(text, '\n')
+		if i < 0 {
+			lines = append(lines, text)
+			break
+		}
+		lines = append(lines, text[:i+1])
+		text = text[i+1:]
+	}
+	return lines
+}
+
+func diffLines(a, b []string) []op {
+	// fixes are usually small, so most of the lines are shared
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]op, 0, max(len(a), len(b)))
+	for _, line := range a[:prefix] {
+		ops = append(ops, op{opEqual, line})
+	}
+	ops = append(ops, myers(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, line := range a[len(a)-suffix:] {
+		ops = append(ops, op{opEqual, line})
+	}
+	return ops
+}
+
+// myers finds the shortest edit script from a to b with the algorithm from
+// "An O(ND) Difference Algorithm and Its Variations" by Eugene W. Myers.
+func myers(a, b []string) []op {
+	n, m := len(a), len(b)
+	if n == 0 && m == 0 {
+		return nil
+	}
+
+	// v[offset+k] is the furthest x reached on diagonal k
+	offset := n + m + 1
+	v := make([]int, 2*offset+1)
+	var trace [][]int
+
+search:
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, slices.Clone(v))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	reversed := make([]op, 0, n+m)
+	x, y := n, m
+	for d := len(trace) - 1; d > 0; d-- {
+		v := trace[d]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			reversed = append(reversed, op{opEqual, a[x-1]})
+			x--
+			y--
+		}
+		if x == prevX {
+			reversed = append(reversed, op{opInsert, b[y-1]})
+		} else {
+			reversed = append(reversed, op{opDelete, a[x-1]})
+		}
+		x, y = prevX, prevY
+	}
+	for x > 0 && y > 0 {
+		reversed = append(reversed, op{opEqual, a[x-1]})
+		x--
+		y--
+	}
+
+	slices.Reverse(reversed)
+	return reversed
+}
@@ -0,0 +1,124 @@
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestUnified(t *testing.T) {
+	cases := []struct {
+		name     string
+		old      string
+		new      string
+		expected string
+	}{
+		{
+			name:     "equal",
+			old:      "a\nb\n",
+			new:      "a\nb\n",
+			expected: "",
+		},
+		{
+			name: "changed line",
+			old:  "a\nb\nc\n",
+			new:  "a\nB\nc\n",
+			expected: `--- a/file.ts
++++ b/file.ts
+@@ -1,3 +1,3 @@
+ a
+-b
++B
+ c
+`,
+		},
+		{
+			name: "insertion into empty file",
+			old:  "",
+			new:  "a\n",
+			expected: `--- a/file.ts
++++ b/file.ts
+@@ -0,0 +1 @@
++a
+`,
+		},
+		{
+			name: "missing newline at the end",
+			old:  "a\nb",
+			new:  "a\nc",
+			expected: `--- a/file.ts
++++ b/file.ts
+@@ -1,2 +1,2 @@
+ a
+-b
+\ No newline at end of file
++c
+\ No newline at end of file
+`,
+		},
+		{
+			name: "distant changes are split into hunks",
+			old:  "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			new:  "one\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\ntwelve\n",
+			expected: `--- a/file.ts
++++ b/file.ts
+@@ -1,4 +1,4 @@
+-1
++one
+ 2
+ 3
+ 4
+@@ -9,4 +9,4 @@
+ 9
+ 10
+ 11
+-12
++twelve
+`,
+		},
+		{
+			name: "close changes share a hunk",
+			old:  "1\n2\n3\n4\n5\n6\n7\n8\n",
+			new:  "2\n3\n4\n5\n6\n7\nseven and a half\n8\n",
+			expected: `--- a/file.ts
++++ b/file.ts
+@@ -1,8 +1,8 @@
+-1
+ 2
+ 3
+ 4
+ 5
+ 6
+ 7
++seven and a half
+ 8
+`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, Unified("a/file.ts", "b/file.ts", c.old, c.new), c.expected)
+		})
+	}
+}
+
+func TestEdits(t *testing.T) {
+	old := "a\nb\nc\nd\n"
+	new := "a\nB\nc\nd\ne\n"
+	edits := Edits(old, new)
+	assert.DeepEqual(t, edits, []Edit{
+		{Start: 2, End: 4, Text: "B\n"},
+		{Start: 8, End: 8, Text: "e\n"},
+	})
+
+	var b strings.Builder
+	last := 0
+	for _, e := range edits {
+		b.WriteString(old[last:e.Start])
+		b.WriteString(e.Text)
+		last = e.End
+	}
+	b.WriteString(old[last:])
+	assert.Equal(t, b.String(), new)
+}
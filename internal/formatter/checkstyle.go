@@ -0,0 +1,83 @@
+package formatter
+
+import (
+	"encoding/xml"
+	"io"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+)
+
+type checkstyleReport struct {
+	XMLName xml.Name         `xml:"checkstyle"`
+	Version string           `xml:"version,attr"`
+	Files   []checkstyleFile `xml:"file"`
+}
+
+type checkstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []checkstyleError `xml:"error"`
+}
+
+type checkstyleError struct {
+	Line     int    `xml:"line,attr"`
+	Column   int    `xml:"column,attr"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+// CheckstyleFormatter writes the XML format of Checkstyle, which is read by
+// many CI servers and code review tools.
+type CheckstyleFormatter struct {
+	w                  io.Writer
+	comparePathOptions tspath.ComparePathsOptions
+	diagnostics        []Diagnostic
+}
+
+var _ Formatter = (*CheckstyleFormatter)(nil)
+
+func NewCheckstyleFormatter(w io.Writer, comparePathOptions tspath.ComparePathsOptions) *CheckstyleFormatter {
+	return &CheckstyleFormatter{
+		w:                  w,
+		comparePathOptions: comparePathOptions,
+	}
+}
+
+func (f *CheckstyleFormatter) AddDiagnostic(d rule.RuleDiagnostic) {
+	f.diagnostics = append(f.diagnostics, NewDiagnostic(d, f.comparePathOptions))
+}
+
+func (f *CheckstyleFormatter) Finish() error {
+	sortDiagnostics(f.diagnostics)
+
+	report := checkstyleReport{Version: "4.3"}
+	for _, d := range f.diagnostics {
+		if len(report.Files) == 0 || report.Files[len(report.Files)-1].Name != d.File {
+			report.Files = append(report.Files, checkstyleFile{Name: d.File})
+		}
+		file := &report.Files[len(report.Files)-1]
+		file.Errors = append(file.Errors, checkstyleError{
+			Line:     d.Range.Start.Line,
+			Column:   d.Range.Start.Column,
+			Severity: d.Severity,
+			Message:  d.Message + " (" + d.Rule + ")",
+			Source:   "tsgolint.rules." + d.Rule,
+		})
+	}
+
+	return writeXML(f.w, report)
+}
+
+func writeXML(w io.Writer, v any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
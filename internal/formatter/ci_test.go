@@ -0,0 +1,122 @@
+package formatter
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"gotest.tools/v3/assert"
+)
+
+func formatTestCode(t *testing.T, newFormatter func(w io.Writer, comparePathOptions tspath.ComparePathsOptions) Formatter) string {
+	t.Helper()
+
+	diagnostics, comparePathOptions := lintTestCode(t)
+	var out bytes.Buffer
+	f := newFormatter(&out, comparePathOptions)
+	for _, d := range diagnostics {
+		f.AddDiagnostic(d)
+	}
+	assert.NilError(t, f.Finish())
+	return out.String()
+}
+
+func TestCompactFormatter(t *testing.T) {
+	out := formatTestCode(t, func(w io.Writer, comparePathOptions tspath.ComparePathsOptions) Formatter {
+		return NewCompactFormatter(w, comparePathOptions)
+	})
+	assert.Equal(t, out, `file.ts:1:9: test-rule — Unexpected number.
+file.ts:2:9: test-warning-rule — Unexpected <string>.
+`)
+}
+
+func TestGitHubFormatter(t *testing.T) {
+	out := formatTestCode(t, func(w io.Writer, comparePathOptions tspath.ComparePathsOptions) Formatter {
+		return NewGitHubFormatter(w, comparePathOptions)
+	})
+	assert.Equal(t, out, `::error file=file.ts,line=1,col=9,endLine=1,endColumn=10,title=test-rule::Unexpected number.
+::warning file=file.ts,line=2,col=9,endLine=2,endColumn=14,title=test-warning-rule::Unexpected <string>.
+`)
+
+	assert.Equal(t, escapeGitHubData("100%\nsure: yes, no"), "100%25%0Asure: yes, no")
+	assert.Equal(t, escapeGitHubProperty("a:b,c"), "a%3Ab%2Cc")
+}
+
+func TestGitLabFormatter(t *testing.T) {
+	out := formatTestCode(t, func(w io.Writer, comparePathOptions tspath.ComparePathsOptions) Formatter {
+		return NewGitLabFormatter(w, comparePathOptions)
+	})
+
+	var issues []gitLabIssue
+	assert.NilError(t, json.Unmarshal([]byte(out), &issues))
+	assert.Equal(t, len(issues), 2)
+	assert.Equal(t, issues[0].CheckName, "test-rule")
+	assert.Equal(t, issues[0].Severity, "major")
+	assert.DeepEqual(t, issues[0].Location, gitLabLocation{
+		Path: "file.ts",
+		Positions: gitLabPositions{
+			Begin: gitLabPosition{Line: 1, Column: 9},
+			End:   gitLabPosition{Line: 1, Column: 10},
+		},
+	})
+	assert.Equal(t, issues[1].Severity, "minor")
+	assert.Assert(t, issues[0].Fingerprint != issues[1].Fingerprint)
+}
+
+func TestGitLabFingerprintsAreStable(t *testing.T) {
+	diagnostics, comparePathOptions := lintTestCode(t)
+
+	fingerprints := func(diagnostics []rule.RuleDiagnostic) []string {
+		var out bytes.Buffer
+		f := NewGitLabFormatter(&out, comparePathOptions)
+		for _, d := range diagnostics {
+			f.AddDiagnostic(d)
+		}
+		assert.NilError(t, f.Finish())
+		var issues []gitLabIssue
+		assert.NilError(t, json.Unmarshal(out.Bytes(), &issues))
+		result := make([]string, len(issues))
+		for i, issue := range issues {
+			result[i] = issue.Fingerprint
+		}
+		return result
+	}
+
+	reversed := []rule.RuleDiagnostic{diagnostics[1], diagnostics[0]}
+	assert.DeepEqual(t, fingerprints(diagnostics), fingerprints(reversed))
+}
+
+func TestCheckstyleFormatter(t *testing.T) {
+	out := formatTestCode(t, func(w io.Writer, comparePathOptions tspath.ComparePathsOptions) Formatter {
+		return NewCheckstyleFormatter(w, comparePathOptions)
+	})
+	assert.Equal(t, out, `<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="4.3">
+  <file name="file.ts">
+    <error line="1" column="9" severity="error" message="Unexpected number. (test-rule)" source="tsgolint.rules.test-rule"></error>
+    <error line="2" column="9" severity="warning" message="Unexpected &lt;string&gt;. (test-warning-rule)" source="tsgolint.rules.test-warning-rule"></error>
+  </file>
+</checkstyle>
+`)
+}
+
+func TestJUnitFormatter(t *testing.T) {
+	out := formatTestCode(t, func(w io.Writer, comparePathOptions tspath.ComparePathsOptions) Formatter {
+		return NewJUnitFormatter(w, comparePathOptions)
+	})
+	assert.Equal(t, out, `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="file.ts" tests="2" failures="2" errors="0">
+    <testcase name="tsgolint.rules.test-rule" classname="file" time="0">
+      <failure message="Unexpected number."><![CDATA[line 1, col 9, Error - Unexpected number. (test-rule)]]></failure>
+    </testcase>
+    <testcase name="tsgolint.rules.test-warning-rule" classname="file" time="0">
+      <failure message="Unexpected &lt;string&gt;."><![CDATA[line 2, col 9, Warning - Unexpected <string>. (test-warning-rule)]]></failure>
+    </testcase>
+  </testsuite>
+</testsuites>
+`)
+}
@@ -0,0 +1,42 @@
+package formatter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+)
+
+// CompactFormatter prints a line per problem, in the "path:line:col: message"
+// shape that editors' problem matchers understand.
+type CompactFormatter struct {
+	w                  io.Writer
+	comparePathOptions tspath.ComparePathsOptions
+	diagnostics        []Diagnostic
+}
+
+var _ Formatter = (*CompactFormatter)(nil)
+
+func NewCompactFormatter(w io.Writer, comparePathOptions tspath.ComparePathsOptions) *CompactFormatter {
+	return &CompactFormatter{
+		w:                  w,
+		comparePathOptions: comparePathOptions,
+	}
+}
+
+func (f *CompactFormatter) AddDiagnostic(d rule.RuleDiagnostic) {
+	f.diagnostics = append(f.diagnostics, NewDiagnostic(d, f.comparePathOptions))
+}
+
+func (f *CompactFormatter) Finish() error {
+	sortDiagnostics(f.diagnostics)
+	for _, d := range f.diagnostics {
+		message := strings.Join(strings.Fields(d.Message), " ")
+		if _, err := fmt.Fprintf(f.w, "%v:%v:%v: %v — %v\n", d.File, d.Range.Start.Line, d.Range.Start.Column, d.Rule, message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+// Package formatter writes lint results in machine-readable formats.
+package formatter
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+)
+
+// Formatter collects diagnostics and writes them out. AddDiagnostic is only
+// called from one goroutine at a time and Finish is called once after linting.
+type Formatter interface {
+	AddDiagnostic(d rule.RuleDiagnostic)
+	Finish() error
+}
+
+type Position struct {
+	// 1-based
+	Line int `json:"line"`
+	// 1-based
+	Column int `json:"column"`
+	// 0-based byte offset into the file
+	Offset int `json:"offset"`
+}
+
+type Range struct {
+	Start Position `json:"start"`
+	End   Position `json:"end"`
+}
+
+type Edit struct {
+	Range Range  `json:"range"`
+	Text  string `json:"text"`
+}
+
+type Suggestion struct {
+	MessageId string `json:"messageId"`
+	Message   string `json:"message"`
+	Edits     []Edit `json:"edits"`
+}
+
+type Diagnostic struct {
+	// relative to the current directory
+	File        string       `json:"file"`
+	Rule        string       `json:"rule"`
+	MessageId   string       `json:"messageId"`
+	Message     string       `json:"message"`
+	Severity    string       `json:"severity"`
+	Range       Range        `json:"range"`
+	Fixes       []Edit       `json:"fixes"`
+	Suggestions []Suggestion `json:"suggestions"`
+}
+
+func position(file *ast.SourceFile, offset int) Position {
+	line, column := scanner.GetLineAndCharacterOfPosition(file, offset)
+	return Position{Line: line + 1, Column: column + 1, Offset: offset}
+}
+
+func textRange(file *ast.SourceFile, r core.TextRange) Range {
+	return Range{Start: position(file, r.Pos()), End: position(file, r.End())}
+}
+
+func edits(file *ast.SourceFile, fixes []rule.RuleFix) []Edit {
+	edits := make([]Edit, len(fixes))
+	for i, fix := range fixes {
+		edits[i] = Edit{Range: textRange(file, fix.Range), Text: fix.Text}
+	}
+	return edits
+}
+
+// NewDiagnostic resolves the positions of a diagnostic and its fixes.
+func NewDiagnostic(d rule.RuleDiagnostic, comparePathOptions tspath.ComparePathsOptions) Diagnostic {
+	suggestions := []Suggestion{}
+	if d.Suggestions != nil {
+		for _, s := range *d.Suggestions {
+			suggestions = append(suggestions, Suggestion{
+				MessageId: s.Message.Id,
+				Message:   s.Message.Description,
+				Edits:     edits(d.SourceFile, s.Fixes()),
+			})
+		}
+	}
+	return Diagnostic{
+		File:        tspath.ConvertToRelativePath(d.SourceFile.FileName(), comparePathOptions),
+		Rule:        d.RuleName,
+		MessageId:   d.Message.Id,
+		Message:     d.Message.Description,
+		Severity:    d.Severity.String(),
+		Range:       textRange(d.SourceFile, d.Range),
+		Fixes:       edits(d.SourceFile, d.Fixes()),
+		Suggestions: suggestions,
+	}
+}
+
+// Linting is parallel, so diagnostics arrive in no particular order.
+// Formats that aren't streamed are sorted to keep the output stable.
+func sortDiagnostics(diagnostics []Diagnostic) {
+	slices.SortStableFunc(diagnostics, compareDiagnostics)
+}
+
+func compareDiagnostics(a, b Diagnostic) int {
+	return cmp.Or(
+		cmp.Compare(a.File, b.File),
+		cmp.Compare(a.Range.Start.Offset, b.Range.Start.Offset),
+		cmp.Compare(a.Range.End.Offset, b.Range.End.Offset),
+		cmp.Compare(a.Rule, b.Rule),
+		cmp.Compare(a.MessageId, b.MessageId),
+	)
+}
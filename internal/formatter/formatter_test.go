@@ -0,0 +1,77 @@
+package formatter
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/linter"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/rules/fixtures"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+	"gotest.tools/v3/assert"
+)
+
+const testCode = "let a = 1;\nlet b = 'two';\n"
+
+// Reports number literals as errors with a fix and string literals as
+// warnings with a suggestion
+var testRule = linter.ConfiguredRule{
+	Name: "test-rule",
+	Run: func(ctx rule.RuleContext) rule.RuleListeners {
+		return rule.RuleListeners{
+			ast.KindNumericLiteral: func(node *ast.Node) {
+				ctx.ReportNodeWithFixes(node, rule.RuleMessage{Id: "number", Description: "Unexpected number."}, rule.RuleFix{
+					Text:  "one",
+					Range: utils.TrimNodeTextRange(ctx.SourceFile, node),
+				})
+			},
+		}
+	},
+}
+
+var testWarningRule = linter.ConfiguredRule{
+	Name:     "test-warning-rule",
+	Severity: rule.SeverityWarning,
+	Run: func(ctx rule.RuleContext) rule.RuleListeners {
+		return rule.RuleListeners{
+			ast.KindStringLiteral: func(node *ast.Node) {
+				ctx.ReportNodeWithSuggestions(node, rule.RuleMessage{Id: "string", Description: "Unexpected <string>."}, rule.RuleSuggestion{
+					Message:  rule.RuleMessage{Id: "useNumber", Description: "Use a number."},
+					FixesArr: []rule.RuleFix{{Text: "2", Range: utils.TrimNodeTextRange(ctx.SourceFile, node)}},
+				})
+			},
+		}
+	},
+}
+
+func lintTestCode(t *testing.T) ([]rule.RuleDiagnostic, tspath.ComparePathsOptions) {
+	t.Helper()
+
+	rootDir := fixtures.GetRootDir()
+	fs := utils.NewOverlayVFSForFile(tspath.ResolvePath(rootDir, "file.ts"), testCode)
+	host := utils.CreateCompilerHost(rootDir, fs)
+	program, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.json", host)
+	assert.NilError(t, err)
+
+	var mu sync.Mutex
+	diagnostics := []rule.RuleDiagnostic{}
+	err = linter.RunLinter(
+		program,
+		true,
+		[]*ast.SourceFile{program.GetSourceFile("file.ts")},
+		func(sourceFile *ast.SourceFile) []linter.ConfiguredRule {
+			return []linter.ConfiguredRule{testWarningRule, testRule}
+		},
+		linter.Options{},
+		func(d rule.RuleDiagnostic) {
+			mu.Lock()
+			defer mu.Unlock()
+			diagnostics = append(diagnostics, d)
+		},
+	)
+	assert.NilError(t, err)
+
+	return diagnostics, tspath.ComparePathsOptions{CurrentDirectory: rootDir}
+}
@@ -0,0 +1,71 @@
+package formatter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+)
+
+// GitHubFormatter prints GitHub Actions workflow commands, which show up as
+// annotations on the changed lines of pull requests.
+// https://docs.github.com/en/actions/reference/workflow-commands-for-github-actions
+type GitHubFormatter struct {
+	w                  io.Writer
+	comparePathOptions tspath.ComparePathsOptions
+	diagnostics        []Diagnostic
+}
+
+var _ Formatter = (*GitHubFormatter)(nil)
+
+func NewGitHubFormatter(w io.Writer, comparePathOptions tspath.ComparePathsOptions) *GitHubFormatter {
+	return &GitHubFormatter{
+		w:                  w,
+		comparePathOptions: comparePathOptions,
+	}
+}
+
+func (f *GitHubFormatter) AddDiagnostic(d rule.RuleDiagnostic) {
+	f.diagnostics = append(f.diagnostics, NewDiagnostic(d, f.comparePathOptions))
+}
+
+func (f *GitHubFormatter) Finish() error {
+	sortDiagnostics(f.diagnostics)
+	for _, d := range f.diagnostics {
+		command := "error"
+		if d.Severity == rule.SeverityWarning.String() {
+			command = "warning"
+		}
+		_, err := fmt.Fprintf(
+			f.w,
+			"::%v file=%v,line=%v,col=%v,endLine=%v,endColumn=%v,title=%v::%v\n",
+			command,
+			escapeGitHubProperty(d.File),
+			d.Range.Start.Line,
+			d.Range.Start.Column,
+			d.Range.End.Line,
+			d.Range.End.Column,
+			escapeGitHubProperty(d.Rule),
+			escapeGitHubData(d.Message),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	gitHubDataEscaper     = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A")
+	gitHubPropertyEscaper = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C")
+)
+
+func escapeGitHubData(s string) string {
+	return gitHubDataEscaper.Replace(s)
+}
+
+func escapeGitHubProperty(s string) string {
+	return gitHubPropertyEscaper.Replace(s)
+}
@@ -0,0 +1,108 @@
+package formatter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"slices"
+	"strconv"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+)
+
+// https://docs.gitlab.com/ci/testing/code_quality/#code-quality-report-format
+
+type gitLabIssue struct {
+	Description string         `json:"description"`
+	CheckName   string         `json:"check_name"`
+	Fingerprint string         `json:"fingerprint"`
+	Severity    string         `json:"severity"`
+	Location    gitLabLocation `json:"location"`
+}
+
+type gitLabLocation struct {
+	Path      string          `json:"path"`
+	Positions gitLabPositions `json:"positions"`
+}
+
+type gitLabPositions struct {
+	Begin gitLabPosition `json:"begin"`
+	End   gitLabPosition `json:"end"`
+}
+
+type gitLabPosition struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+type gitLabDiagnostic struct {
+	Diagnostic
+	// the reported code, which identifies a problem better than its position
+	text string
+}
+
+// GitLabFormatter writes a Code Quality report. GitLab compares the issues of
+// the merge request and the target branch by their fingerprints, so these
+// don't depend on line numbers and stay the same when unrelated code changes.
+type GitLabFormatter struct {
+	w                  io.Writer
+	comparePathOptions tspath.ComparePathsOptions
+	diagnostics        []gitLabDiagnostic
+}
+
+var _ Formatter = (*GitLabFormatter)(nil)
+
+func NewGitLabFormatter(w io.Writer, comparePathOptions tspath.ComparePathsOptions) *GitLabFormatter {
+	return &GitLabFormatter{
+		w:                  w,
+		comparePathOptions: comparePathOptions,
+	}
+}
+
+func (f *GitLabFormatter) AddDiagnostic(d rule.RuleDiagnostic) {
+	f.diagnostics = append(f.diagnostics, gitLabDiagnostic{
+		Diagnostic: NewDiagnostic(d, f.comparePathOptions),
+		text:       d.SourceFile.Text()[d.Range.Pos():d.Range.End()],
+	})
+}
+
+func (f *GitLabFormatter) Finish() error {
+	slices.SortStableFunc(f.diagnostics, func(a, b gitLabDiagnostic) int {
+		return compareDiagnostics(a.Diagnostic, b.Diagnostic)
+	})
+
+	// identical problems in a file are told apart by the order they appear in
+	issues := make([]gitLabIssue, len(f.diagnostics))
+	occurrences := map[string]int{}
+	for i, d := range f.diagnostics {
+		key := d.File + "\x00" + d.Rule + "\x00" + d.MessageId + "\x00" + d.text
+		occurrence := occurrences[key]
+		occurrences[key]++
+		hash := sha256.Sum256([]byte(key + "\x00" + strconv.Itoa(occurrence)))
+
+		severity := "major"
+		if d.Severity == rule.SeverityWarning.String() {
+			severity = "minor"
+		}
+		issues[i] = gitLabIssue{
+			Description: d.Message,
+			CheckName:   d.Rule,
+			Fingerprint: hex.EncodeToString(hash[:]),
+			Severity:    severity,
+			Location: gitLabLocation{
+				Path: d.File,
+				Positions: gitLabPositions{
+					Begin: gitLabPosition{Line: d.Range.Start.Line, Column: d.Range.Start.Column},
+					End:   gitLabPosition{Line: d.Range.End.Line, Column: d.Range.End.Column},
+				},
+			},
+		}
+	}
+
+	encoder := json.NewEncoder(f.w)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(issues)
+}
@@ -0,0 +1,90 @@
+package formatter
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+)
+
+type RuleTiming struct {
+	Rule string `json:"rule"`
+	// in milliseconds
+	Time float64 `json:"time"`
+	// of the time spent in all rules
+	Percent float64 `json:"percent"`
+}
+
+// Timing is where linting spent its time, in milliseconds. Work done in
+// parallel is summed across threads.
+type Timing struct {
+	ProgramCreation float64 `json:"programCreation"`
+	Binding         float64 `json:"binding"`
+	// only with --type-check, since the types rules ask for count toward them
+	TypeChecking *float64 `json:"typeChecking,omitempty"`
+	// slowest first
+	Rules []RuleTiming `json:"rules"`
+}
+
+// FileStats is the work linting a file took, with times in milliseconds.
+type FileStats struct {
+	File string `json:"file"`
+	// spent in rule listeners, including the types they ask for
+	ListenersTime float64 `json:"listenersTime"`
+	TypeCheckTime float64 `json:"typeCheckTime"`
+	Nodes         int     `json:"nodes"`
+	Diagnostics   int     `json:"diagnostics"`
+}
+
+type jsonReport struct {
+	Diagnostics  []Diagnostic `json:"diagnostics"`
+	ErrorCount   int          `json:"errorCount"`
+	WarningCount int          `json:"warningCount"`
+	Timing       *Timing      `json:"timing,omitempty"`
+	// slowest first
+	Stats []FileStats `json:"stats,omitempty"`
+}
+
+type JSONFormatter struct {
+	w                  io.Writer
+	comparePathOptions tspath.ComparePathsOptions
+	report             jsonReport
+}
+
+var _ Formatter = (*JSONFormatter)(nil)
+
+func NewJSONFormatter(w io.Writer, comparePathOptions tspath.ComparePathsOptions) *JSONFormatter {
+	return &JSONFormatter{
+		w:                  w,
+		comparePathOptions: comparePathOptions,
+		report:             jsonReport{Diagnostics: []Diagnostic{}},
+	}
+}
+
+func (f *JSONFormatter) AddDiagnostic(d rule.RuleDiagnostic) {
+	if d.Severity == rule.SeverityWarning {
+		f.report.WarningCount++
+	} else {
+		f.report.ErrorCount++
+	}
+	f.report.Diagnostics = append(f.report.Diagnostics, NewDiagnostic(d, f.comparePathOptions))
+}
+
+// SetTiming adds the time linting took to the report, for --timing.
+func (f *JSONFormatter) SetTiming(timing Timing) {
+	f.report.Timing = &timing
+}
+
+// SetStats adds the work done for each file to the report, for --stats.
+func (f *JSONFormatter) SetStats(stats []FileStats) {
+	f.report.Stats = stats
+}
+
+func (f *JSONFormatter) Finish() error {
+	sortDiagnostics(f.report.Diagnostics)
+	encoder := json.NewEncoder(f.w)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(f.report)
+}
@@ -0,0 +1,174 @@
+package formatter
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestJSONFormatter(t *testing.T) {
+	diagnostics, comparePathOptions := lintTestCode(t)
+
+	var out bytes.Buffer
+	f := NewJSONFormatter(&out, comparePathOptions)
+	for _, d := range diagnostics {
+		f.AddDiagnostic(d)
+	}
+	assert.NilError(t, f.Finish())
+
+	assert.Equal(t, out.String(), `{
+  "diagnostics": [
+    {
+      "file": "file.ts",
+      "rule": "test-rule",
+      "messageId": "number",
+      "message": "Unexpected number.",
+      "severity": "error",
+      "range": {
+        "start": {
+          "line": 1,
+          "column": 9,
+          "offset": 8
+        },
+        "end": {
+          "line": 1,
+          "column": 10,
+          "offset": 9
+        }
+      },
+      "fixes": [
+        {
+          "range": {
+            "start": {
+              "line": 1,
+              "column": 9,
+              "offset": 8
+            },
+            "end": {
+              "line": 1,
+              "column": 10,
+              "offset": 9
+            }
+          },
+          "text": "one"
+        }
+      ],
+      "suggestions": []
+    },
+    {
+      "file": "file.ts",
+      "rule": "test-warning-rule",
+      "messageId": "string",
+      "message": "Unexpected <string>.",
+      "severity": "warning",
+      "range": {
+        "start": {
+          "line": 2,
+          "column": 9,
+          "offset": 19
+        },
+        "end": {
+          "line": 2,
+          "column": 14,
+          "offset": 24
+        }
+      },
+      "fixes": [],
+      "suggestions": [
+        {
+          "messageId": "useNumber",
+          "message": "Use a number.",
+          "edits": [
+            {
+              "range": {
+                "start": {
+                  "line": 2,
+                  "column": 9,
+                  "offset": 19
+                },
+                "end": {
+                  "line": 2,
+                  "column": 14,
+                  "offset": 24
+                }
+              },
+              "text": "2"
+            }
+          ]
+        }
+      ]
+    }
+  ],
+  "errorCount": 1,
+  "warningCount": 1
+}
+`)
+}
+
+func TestJSONFormatterTiming(t *testing.T) {
+	_, comparePathOptions := lintTestCode(t)
+
+	var out bytes.Buffer
+	f := NewJSONFormatter(&out, comparePathOptions)
+	// without --type-check, type checking isn't timed
+	f.SetTiming(Timing{
+		ProgramCreation: 120.5,
+		Binding:         10,
+		Rules: []RuleTiming{
+			{Rule: "test-rule", Time: 3, Percent: 75},
+			{Rule: "test-warning-rule", Time: 1, Percent: 25},
+		},
+	})
+	assert.NilError(t, f.Finish())
+
+	assert.Equal(t, out.String(), `{
+  "diagnostics": [],
+  "errorCount": 0,
+  "warningCount": 0,
+  "timing": {
+    "programCreation": 120.5,
+    "binding": 10,
+    "rules": [
+      {
+        "rule": "test-rule",
+        "time": 3,
+        "percent": 75
+      },
+      {
+        "rule": "test-warning-rule",
+        "time": 1,
+        "percent": 25
+      }
+    ]
+  }
+}
+`)
+}
+
+func TestJSONFormatterStats(t *testing.T) {
+	_, comparePathOptions := lintTestCode(t)
+
+	var out bytes.Buffer
+	f := NewJSONFormatter(&out, comparePathOptions)
+	f.SetStats([]FileStats{
+		{File: "slow.ts", ListenersTime: 1200, TypeCheckTime: 800, Nodes: 50000, Diagnostics: 2},
+	})
+	assert.NilError(t, f.Finish())
+
+	assert.Equal(t, out.String(), `{
+  "diagnostics": [],
+  "errorCount": 0,
+  "warningCount": 0,
+  "stats": [
+    {
+      "file": "slow.ts",
+      "listenersTime": 1200,
+      "typeCheckTime": 800,
+      "nodes": 50000,
+      "diagnostics": 2
+    }
+  ]
+}
+`)
+}
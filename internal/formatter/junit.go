@@ -0,0 +1,91 @@
+package formatter
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+)
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Errors   int             `xml:"errors,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string       `xml:"name,attr"`
+	ClassName string       `xml:"classname,attr"`
+	Time      string       `xml:"time,attr"`
+	Failure   junitFailure `xml:"failure"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",cdata"`
+}
+
+// JUnitFormatter reports every problem as a failed test case, grouped into a
+// test suite per file, like ESLint's junit formatter. Test report viewers of
+// CI servers then list them.
+type JUnitFormatter struct {
+	w                  io.Writer
+	comparePathOptions tspath.ComparePathsOptions
+	diagnostics        []Diagnostic
+}
+
+var _ Formatter = (*JUnitFormatter)(nil)
+
+func NewJUnitFormatter(w io.Writer, comparePathOptions tspath.ComparePathsOptions) *JUnitFormatter {
+	return &JUnitFormatter{
+		w:                  w,
+		comparePathOptions: comparePathOptions,
+	}
+}
+
+func (f *JUnitFormatter) AddDiagnostic(d rule.RuleDiagnostic) {
+	f.diagnostics = append(f.diagnostics, NewDiagnostic(d, f.comparePathOptions))
+}
+
+func (f *JUnitFormatter) Finish() error {
+	sortDiagnostics(f.diagnostics)
+
+	report := junitTestSuites{Suites: []junitTestSuite{}}
+	for _, d := range f.diagnostics {
+		if len(report.Suites) == 0 || report.Suites[len(report.Suites)-1].Name != d.File {
+			report.Suites = append(report.Suites, junitTestSuite{Name: d.File})
+		}
+		suite := &report.Suites[len(report.Suites)-1]
+		suite.Tests++
+		suite.Failures++
+
+		suite.Cases = append(suite.Cases, junitTestCase{
+			Name:      "tsgolint.rules." + d.Rule,
+			ClassName: tspath.RemoveFileExtension(d.File),
+			Time:      "0",
+			Failure: junitFailure{
+				Message: d.Message,
+				Text:    fmt.Sprintf("line %v, col %v, %v - %v (%v)", d.Range.Start.Line, d.Range.Start.Column, capitalize(d.Severity), d.Message, d.Rule),
+			},
+		})
+	}
+
+	return writeXML(f.w, report)
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
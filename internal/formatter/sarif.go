@@ -0,0 +1,242 @@
+package formatter
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+)
+
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+	// relative file paths are resolved against this base, which is set to the
+	// current directory
+	sarifSourceRoot = "%SRCROOT%"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool               sarifTool                        `json:"tool"`
+	OriginalURIBaseIDs map[string]sarifArtifactLocation `json:"originalUriBaseIds"`
+	// columns are counted in code points, see scanner.GetLineAndCharacterOfPosition
+	ColumnKind string        `json:"columnKind"`
+	Results    []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string                     `json:"name"`
+	InformationURI string                     `json:"informationUri"`
+	Rules          []sarifReportingDescriptor `json:"rules"`
+}
+
+type sarifReportingDescriptor struct {
+	ID               string              `json:"id"`
+	ShortDescription sarifMessage        `json:"shortDescription"`
+	HelpURI          string              `json:"helpUri,omitempty"`
+	Properties       sarifRuleProperties `json:"properties"`
+}
+
+type sarifRuleProperties struct {
+	Category       rule.RuleCategory `json:"category,omitempty"`
+	Fixable        bool              `json:"fixable"`
+	HasSuggestions bool              `json:"hasSuggestions"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex *int            `json:"ruleIndex,omitempty"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+	Fixes     []sarifFix      `json:"fixes,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId,omitempty"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine"`
+	EndColumn   int `json:"endColumn"`
+	ByteOffset  int `json:"byteOffset"`
+	ByteLength  int `json:"byteLength"`
+}
+
+type sarifFix struct {
+	Description     sarifMessage          `json:"description"`
+	ArtifactChanges []sarifArtifactChange `json:"artifactChanges"`
+}
+
+type sarifArtifactChange struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Replacements     []sarifReplacement    `json:"replacements"`
+}
+
+type sarifReplacement struct {
+	DeletedRegion   sarifRegion  `json:"deletedRegion"`
+	InsertedContent sarifMessage `json:"insertedContent"`
+}
+
+type SARIFFormatter struct {
+	w                  io.Writer
+	rules              []rule.Rule
+	comparePathOptions tspath.ComparePathsOptions
+	diagnostics        []Diagnostic
+}
+
+var _ Formatter = (*SARIFFormatter)(nil)
+
+// NewSARIFFormatter describes rules in the tool section of the log, so it
+// should get every rule that was run, not only those that reported something.
+func NewSARIFFormatter(w io.Writer, rules []rule.Rule, comparePathOptions tspath.ComparePathsOptions) *SARIFFormatter {
+	return &SARIFFormatter{
+		w:                  w,
+		rules:              rules,
+		comparePathOptions: comparePathOptions,
+	}
+}
+
+func (f *SARIFFormatter) AddDiagnostic(d rule.RuleDiagnostic) {
+	f.diagnostics = append(f.diagnostics, NewDiagnostic(d, f.comparePathOptions))
+}
+
+func (f *SARIFFormatter) Finish() error {
+	sortDiagnostics(f.diagnostics)
+
+	ruleIndexes := make(map[string]int, len(f.rules))
+	descriptors := make([]sarifReportingDescriptor, len(f.rules))
+	for i, r := range f.rules {
+		ruleIndexes[r.Name] = i
+		descriptors[i] = sarifReportingDescriptor{
+			ID:               r.Name,
+			ShortDescription: sarifMessage{r.Meta.Description},
+			HelpURI:          r.Meta.DocsURL,
+			Properties: sarifRuleProperties{
+				Category:       r.Meta.Category,
+				Fixable:        r.Meta.Fixable,
+				HasSuggestions: r.Meta.HasSuggestions,
+			},
+		}
+	}
+
+	results := make([]sarifResult, len(f.diagnostics))
+	for i, d := range f.diagnostics {
+		artifactLocation := sarifArtifactLocation{URI: (&url.URL{Path: d.File}).String(), URIBaseID: sarifSourceRoot}
+		result := sarifResult{
+			RuleID:  d.Rule,
+			Level:   d.Severity,
+			Message: sarifMessage{d.Message},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: artifactLocation,
+					Region:           sarifRegionOf(d.Range),
+				},
+			}},
+		}
+		if ruleIndex, ok := ruleIndexes[d.Rule]; ok {
+			result.RuleIndex = &ruleIndex
+		}
+		if len(d.Fixes) > 0 {
+			result.Fixes = append(result.Fixes, sarifFixOf(d.Message, artifactLocation, d.Fixes))
+		}
+		for _, s := range d.Suggestions {
+			result.Fixes = append(result.Fixes, sarifFixOf(s.Message, artifactLocation, s.Edits))
+		}
+		results[i] = result
+	}
+
+	log := sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           "tsgolint",
+				InformationURI: "https://github.com/typescript-eslint/tsgolint",
+				Rules:          descriptors,
+			}},
+			OriginalURIBaseIDs: map[string]sarifArtifactLocation{
+				sarifSourceRoot: {URI: fileURI(f.comparePathOptions.CurrentDirectory)},
+			},
+			ColumnKind: "unicodeCodePoints",
+			Results:    results,
+		}},
+	}
+
+	encoder := json.NewEncoder(f.w)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(log)
+}
+
+func sarifRegionOf(r Range) sarifRegion {
+	return sarifRegion{
+		StartLine:   r.Start.Line,
+		StartColumn: r.Start.Column,
+		EndLine:     r.End.Line,
+		EndColumn:   r.End.Column,
+		ByteOffset:  r.Start.Offset,
+		ByteLength:  r.End.Offset - r.Start.Offset,
+	}
+}
+
+func sarifFixOf(description string, artifactLocation sarifArtifactLocation, edits []Edit) sarifFix {
+	replacements := make([]sarifReplacement, len(edits))
+	for i, edit := range edits {
+		replacements[i] = sarifReplacement{
+			DeletedRegion:   sarifRegionOf(edit.Range),
+			InsertedContent: sarifMessage{edit.Text},
+		}
+	}
+	return sarifFix{
+		Description: sarifMessage{description},
+		ArtifactChanges: []sarifArtifactChange{{
+			ArtifactLocation: artifactLocation,
+			Replacements:     replacements,
+		}},
+	}
+}
+
+// fileURI converts an absolute, normalized directory path to a file URI
+// ending with a slash, as required for base URIs.
+func fileURI(dir string) string {
+	if !strings.HasPrefix(dir, "/") {
+		// Windows paths like c:/foo
+		dir = "/" + dir
+	}
+	uri := (&url.URL{Scheme: "file", Path: dir}).String()
+	if !strings.HasSuffix(uri, "/") {
+		uri += "/"
+	}
+	return uri
+}
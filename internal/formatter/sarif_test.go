@@ -0,0 +1,71 @@
+package formatter
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"gotest.tools/v3/assert"
+)
+
+func TestSARIFFormatter(t *testing.T) {
+	diagnostics, comparePathOptions := lintTestCode(t)
+
+	var out bytes.Buffer
+	f := NewSARIFFormatter(&out, []rule.Rule{
+		{Name: "test-rule", Meta: rule.RuleMeta{Description: "Test rule", DocsURL: "https://example.com/test-rule", Category: rule.RuleCategoryProblem, Fixable: true}},
+	}, comparePathOptions)
+	for _, d := range diagnostics {
+		f.AddDiagnostic(d)
+	}
+	assert.NilError(t, f.Finish())
+
+	var log sarifLog
+	assert.NilError(t, json.Unmarshal(out.Bytes(), &log))
+	assert.Equal(t, log.Version, "2.1.0")
+	assert.Equal(t, len(log.Runs), 1)
+	run := log.Runs[0]
+
+	assert.DeepEqual(t, run.Tool.Driver.Rules, []sarifReportingDescriptor{{
+		ID:               "test-rule",
+		ShortDescription: sarifMessage{"Test rule"},
+		HelpURI:          "https://example.com/test-rule",
+		Properties:       sarifRuleProperties{Category: rule.RuleCategoryProblem, Fixable: true},
+	}})
+	assert.Equal(t, run.OriginalURIBaseIDs[sarifSourceRoot].URI, fileURI(comparePathOptions.CurrentDirectory))
+
+	assert.Equal(t, len(run.Results), 2)
+	fileLocation := sarifArtifactLocation{URI: "file.ts", URIBaseID: sarifSourceRoot}
+
+	result := run.Results[0]
+	assert.Equal(t, result.RuleID, "test-rule")
+	assert.Equal(t, *result.RuleIndex, 0)
+	assert.Equal(t, result.Level, "error")
+	assert.DeepEqual(t, result.Locations, []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+		ArtifactLocation: fileLocation,
+		Region:           sarifRegion{StartLine: 1, StartColumn: 9, EndLine: 1, EndColumn: 10, ByteOffset: 8, ByteLength: 1},
+	}}})
+	assert.DeepEqual(t, result.Fixes, []sarifFix{{
+		Description: sarifMessage{"Unexpected number."},
+		ArtifactChanges: []sarifArtifactChange{{
+			ArtifactLocation: fileLocation,
+			Replacements: []sarifReplacement{{
+				DeletedRegion:   sarifRegion{StartLine: 1, StartColumn: 9, EndLine: 1, EndColumn: 10, ByteOffset: 8, ByteLength: 1},
+				InsertedContent: sarifMessage{"one"},
+			}},
+		}},
+	}})
+
+	result = run.Results[1]
+	assert.Equal(t, result.RuleID, "test-warning-rule")
+	assert.Assert(t, result.RuleIndex == nil)
+	assert.Equal(t, result.Level, "warning")
+	assert.Equal(t, len(result.Fixes), 1)
+	assert.Equal(t, result.Fixes[0].Description.Text, "Use a number.")
+}
+
+func TestFileURI(t *testing.T) {
+	assert.Equal(t, fileURI("/home/user/my project"), "file:///home/user/my%20project/")
+	assert.Equal(t, fileURI("c:/project/"), "file:///c:/project/")
+}
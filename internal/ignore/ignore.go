@@ -0,0 +1,110 @@
+// Package ignore decides which files aren't linted, from patterns with the
+// syntax of .gitignore files.
+package ignore
+
+import (
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+)
+
+// FileName is the name of the ignore file looked up next to the tsconfig.
+const FileName = ".tsgolintignore"
+
+type pattern struct {
+	glob *utils.Glob
+	// a pattern starting with "!" includes files again
+	negated bool
+	// a pattern ending with "/" only matches directories
+	directoryOnly bool
+}
+
+// Matcher holds patterns relative to a base directory. Like in .gitignore,
+// the last matching pattern wins, and files in an ignored directory can't be
+// included again.
+type Matcher struct {
+	comparePathOptions tspath.ComparePathsOptions
+	patterns           []pattern
+}
+
+func NewMatcher(baseDirectory string, useCaseSensitiveFileNames bool) *Matcher {
+	return &Matcher{
+		comparePathOptions: tspath.ComparePathsOptions{
+			CurrentDirectory:          baseDirectory,
+			UseCaseSensitiveFileNames: useCaseSensitiveFileNames,
+		},
+	}
+}
+
+// AddFile adds the patterns of an ignore file, a pattern per line. Blank
+// lines and lines starting with "#" are skipped.
+func (m *Matcher) AddFile(text string) error {
+	for line := range strings.Lines(text) {
+		line = strings.TrimRight(line, "\r\n")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if err := m.Add(strings.TrimRight(line, " \t")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Add adds a pattern. Patterns without a slash but at the end match at any
+// depth, the others are relative to the base directory.
+func (m *Matcher) Add(p string) error {
+	var result pattern
+	if rest, ok := strings.CutPrefix(p, "!"); ok {
+		result.negated = true
+		p = rest
+	}
+	if rest, ok := strings.CutSuffix(p, "/"); ok {
+		result.directoryOnly = true
+		p = rest
+	}
+	if !strings.Contains(p, "/") {
+		p = "**/" + p
+	}
+
+	glob, err := utils.CompileGlob(p, m.comparePathOptions.UseCaseSensitiveFileNames)
+	if err != nil {
+		return err
+	}
+	result.glob = glob
+	m.patterns = append(m.patterns, result)
+	return nil
+}
+
+func (m *Matcher) matches(relativePath string, isDirectory bool) bool {
+	ignored := false
+	for _, p := range m.patterns {
+		if p.directoryOnly && !isDirectory {
+			continue
+		}
+		if p.glob.Match(relativePath) {
+			ignored = !p.negated
+		}
+	}
+	return ignored
+}
+
+// Ignores reports whether a file or one of its directories is ignored. Files
+// outside of the base directory never are.
+func (m *Matcher) Ignores(fileName string) bool {
+	if len(m.patterns) == 0 {
+		return false
+	}
+	relativePath := tspath.ConvertToRelativePath(fileName, m.comparePathOptions)
+	if strings.HasPrefix(relativePath, "../") || tspath.IsRootedDiskPath(relativePath) {
+		return false
+	}
+
+	for i := range len(relativePath) {
+		if relativePath[i] == '/' && m.matches(relativePath[:i], true) {
+			return true
+		}
+	}
+	return m.matches(relativePath, false)
+}
@@ -0,0 +1,49 @@
+package ignore
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestMatcher(t *testing.T) {
+	m := NewMatcher("/project", true)
+	assert.NilError(t, m.AddFile(`# generated code
+*.generated.ts
+/dist
+build/
+src/legacy/**
+!src/legacy/keep.ts
+
+gen/
+!gen/api.ts
+`))
+
+	cases := []struct {
+		fileName string
+		ignored  bool
+	}{
+		{"/project/src/a.ts", false},
+		{"/project/a.generated.ts", true},
+		{"/project/src/deep/a.generated.ts", true},
+		{"/project/dist/index.ts", true},
+		{"/project/src/dist/index.ts", false},
+		{"/project/build/index.ts", true},
+		{"/project/src/build/index.ts", true},
+		{"/project/build.ts", false},
+		{"/project/src/legacy/old.ts", true},
+		{"/project/src/legacy/keep.ts", false},
+		// files in ignored directories can't be included again
+		{"/project/gen/api.ts", true},
+		{"/other/a.generated.ts", false},
+	}
+	for _, c := range cases {
+		t.Run(c.fileName, func(t *testing.T) {
+			assert.Equal(t, m.Ignores(c.fileName), c.ignored)
+		})
+	}
+}
+
+func TestMatcherWithoutPatterns(t *testing.T) {
+	assert.Assert(t, !NewMatcher("/project", true).Ignores("/project/a.ts"))
+}
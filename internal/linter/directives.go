@@ -0,0 +1,415 @@
+package linter
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+)
+
+type directiveKind uint8
+
+const (
+	directiveDisable directiveKind = iota
+	directiveEnable
+	directiveDisableLine
+	directiveDisableNextLine
+)
+
+var directiveKinds = map[string]directiveKind{
+	"disable":           directiveDisable,
+	"enable":            directiveEnable,
+	"disable-line":      directiveDisableLine,
+	"disable-next-line": directiveDisableNextLine,
+}
+
+// typescript-eslint rules keep their names in tsgolint
+const typescriptESLintPrefix = "@typescript-eslint/"
+
+var directiveRegexp = regexp.MustCompile(`^(tsgolint|eslint)-(disable-next-line|disable-line|disable|enable)(?:\s+|$)`)
+var directiveDescriptionRegexp = regexp.MustCompile(`\s-{2,}(?:\s|$)`)
+
+type directiveRuleName struct {
+	// without the @typescript-eslint/ prefix
+	name string
+	// of the name as written in the comment
+	textRange core.TextRange
+	used      bool
+}
+
+type disableDirective struct {
+	kind directiveKind
+	// the directive as written, e.g. "eslint-disable-next-line"
+	keyword  string
+	isESLint bool
+	comment  ast.CommentRange
+	// 0-based line that a -line or -next-line directive applies to
+	line int
+	// empty if the directive applies to all rules
+	ruleNames []*directiveRuleName
+	// whether an ESLint directive also names rules of other plugins
+	hasOtherRules bool
+	used          bool
+}
+
+// disableDirectives are the directive comments of a single file, in source
+// order.
+type disableDirectives struct {
+	file       *ast.SourceFile
+	directives []*disableDirective
+}
+
+// Finding the comments walks the whole file, so files that can't contain a
+// directive are skipped.
+func mayContainDirectives(text string) bool {
+	return strings.Contains(text, "-disable") || strings.Contains(text, "-enable")
+}
+
+func parseDisableDirectives(file *ast.SourceFile) *disableDirectives {
+	result := &disableDirectives{file: file}
+	if !mayContainDirectives(file.Text()) {
+		return result
+	}
+	for _, comment := range getAllComments(file) {
+		if directive := parseDisableDirective(file, comment); directive != nil {
+			result.directives = append(result.directives, directive)
+		}
+	}
+	return result
+}
+
+func parseDisableDirective(file *ast.SourceFile, comment ast.CommentRange) *disableDirective {
+	text := file.Text()
+	// strip the comment delimiters
+	bodyPos, bodyEnd := comment.Pos()+2, comment.End()
+	if comment.Kind == ast.KindMultiLineCommentTrivia {
+		bodyEnd = max(bodyPos, bodyEnd-2)
+	}
+	body := text[bodyPos:bodyEnd]
+
+	trimmed := strings.TrimLeft(body, " \t\r\n*")
+	bodyPos += len(body) - len(trimmed)
+	body = trimmed
+	// everything after "--" describes why the directive is there
+	if loc := directiveDescriptionRegexp.FindStringIndex(body); loc != nil {
+		body = body[:loc[0]]
+	}
+	body = strings.TrimRight(body, " \t\r\n*")
+
+	match := directiveRegexp.FindStringSubmatch(body)
+	if match == nil {
+		return nil
+	}
+	isESLint := match[1] == "eslint"
+	directive := &disableDirective{
+		kind:     directiveKinds[match[2]],
+		keyword:  match[1] + "-" + match[2],
+		isESLint: isESLint,
+		comment:  comment,
+	}
+
+	commentLine, _ := scanner.GetLineAndCharacterOfPosition(file, comment.Pos())
+	endLine, _ := scanner.GetLineAndCharacterOfPosition(file, comment.End())
+	switch directive.kind {
+	case directiveDisable, directiveEnable:
+		// like ESLint, blocks are only turned off or on by block comments
+		if comment.Kind != ast.KindMultiLineCommentTrivia {
+			return nil
+		}
+	case directiveDisableLine:
+		if commentLine != endLine {
+			return nil
+		}
+		directive.line = commentLine
+	case directiveDisableNextLine:
+		directive.line = endLine + 1
+	}
+
+	offset := len(match[0])
+	for _, name := range strings.Split(body[offset:], ",") {
+		trimmedName := strings.TrimSpace(name)
+		namePos := bodyPos + offset + strings.Index(name, trimmedName)
+		offset += len(name) + 1
+		if trimmedName == "" {
+			continue
+		}
+
+		ruleName := strings.TrimPrefix(trimmedName, typescriptESLintPrefix)
+		// other ESLint rules are left to ESLint
+		if isESLint && ruleName == trimmedName {
+			directive.hasOtherRules = true
+			continue
+		}
+		directive.ruleNames = append(directive.ruleNames, &directiveRuleName{
+			name:      ruleName,
+			textRange: core.NewTextRange(namePos, namePos+len(trimmedName)),
+		})
+	}
+
+	// An ESLint directive that only names rules of other plugins doesn't
+	// affect tsgolint.
+	if len(directive.ruleNames) == 0 && directive.hasOtherRules {
+		return nil
+	}
+
+	return directive
+}
+
+// getAllComments finds the comments of a file by looking at the trivia
+// around every node. Scanning the text on its own can't tell comments from
+// regular expressions, template literals and JSX text.
+func getAllComments(file *ast.SourceFile) []ast.CommentRange {
+	var comments []ast.CommentRange
+	seen := map[int]struct{}{}
+	collect := func(pos int) {
+		for comment := range utils.GetCommentsInRange(file, core.NewTextRange(pos, file.End())) {
+			if _, ok := seen[comment.Pos()]; ok {
+				continue
+			}
+			seen[comment.Pos()] = struct{}{}
+			comments = append(comments, comment)
+		}
+	}
+
+	collect(0)
+	var visitor ast.Visitor
+	visitor = func(node *ast.Node) bool {
+		// "//" in JSX text isn't a comment
+		if node.Kind != ast.KindJsxText {
+			collect(node.Pos())
+		}
+		switch node.Kind {
+		case ast.KindJsxText, ast.KindJsxExpression, ast.KindJsxElement, ast.KindJsxSelfClosingElement, ast.KindJsxFragment:
+		default:
+			collect(node.End())
+		}
+		node.ForEachChild(visitor)
+		return false
+	}
+	file.Node.ForEachChild(visitor)
+
+	slices.SortFunc(comments, func(a, b ast.CommentRange) int {
+		return a.Pos() - b.Pos()
+	})
+	return comments
+}
+
+func (d *disableDirective) matchRule(ruleName string) *directiveRuleName {
+	for _, name := range d.ruleNames {
+		if name.name == ruleName {
+			return name
+		}
+	}
+	return nil
+}
+
+// suppress marks the directive that turns off the diagnostic as used and
+// reports whether there was one.
+func (d *disableDirectives) suppress(diagnostic rule.RuleDiagnostic) bool {
+	if len(d.directives) == 0 {
+		return false
+	}
+
+	pos := diagnostic.Range.Pos()
+	line, _ := scanner.GetLineAndCharacterOfPosition(d.file, pos)
+
+	// the last disable or enable comment before the diagnostic wins
+	var block *disableDirective
+	var blockName *directiveRuleName
+	for _, directive := range d.directives {
+		if directive.comment.Pos() > pos {
+			break
+		}
+		name := directive.matchRule(diagnostic.RuleName)
+		if len(directive.ruleNames) != 0 && name == nil {
+			continue
+		}
+		switch directive.kind {
+		case directiveDisable:
+			block, blockName = directive, name
+		case directiveEnable:
+			block, blockName = nil, nil
+		}
+	}
+	if block != nil {
+		markUsed(block, blockName)
+		return true
+	}
+
+	for _, directive := range d.directives {
+		if (directive.kind != directiveDisableLine && directive.kind != directiveDisableNextLine) || directive.line != line {
+			continue
+		}
+		name := directive.matchRule(diagnostic.RuleName)
+		if len(directive.ruleNames) != 0 && name == nil {
+			continue
+		}
+		markUsed(directive, name)
+		return true
+	}
+
+	return false
+}
+
+func markUsed(directive *disableDirective, name *directiveRuleName) {
+	directive.used = true
+	if name != nil {
+		name.used = true
+	}
+}
+
+// UnusedDirectiveRuleName is the rule name of the diagnostics reported for
+// disable directives that didn't suppress anything.
+const UnusedDirectiveRuleName = "unused-disable-directive"
+
+func buildUnusedDirectiveMessage(keyword string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unusedDirective",
+		Description: fmt.Sprintf("Unused %v directive (no problems were reported).", keyword),
+	}
+}
+
+func buildUnusedRuleMessage(keyword string, ruleName string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unusedRule",
+		Description: fmt.Sprintf("Unused %v directive (no problems were reported from '%v').", keyword, ruleName),
+	}
+}
+
+func buildUnknownRuleMessage(ruleName string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unknownRule",
+		Description: fmt.Sprintf("Definition for rule '%v' was not found.", ruleName),
+	}
+}
+
+// unused reports the disable directives that suppressed nothing and the rule
+// names of directives that don't exist, with fixes that remove them.
+// isKnownRule may be nil if every name is known.
+func (d *disableDirectives) unused(isKnownRule func(name string) bool) []rule.RuleDiagnostic {
+	var diagnostics []rule.RuleDiagnostic
+	report := func(textRange core.TextRange, msg rule.RuleMessage, fix rule.RuleFix) {
+		fixes := []rule.RuleFix{fix}
+		diagnostics = append(diagnostics, rule.RuleDiagnostic{
+			RuleName:   UnusedDirectiveRuleName,
+			Range:      textRange,
+			Message:    msg,
+			FixesPtr:   &fixes,
+			SourceFile: d.file,
+		})
+	}
+
+	for _, directive := range d.directives {
+		if directive.kind == directiveEnable {
+			continue
+		}
+
+		if len(directive.ruleNames) == 0 {
+			// a bare eslint-disable may be there for other ESLint rules
+			if !directive.used && !directive.isESLint {
+				report(directive.comment.TextRange, buildUnusedDirectiveMessage(directive.keyword), d.removeComment(directive))
+			}
+			continue
+		}
+
+		type unusedName struct {
+			name    *directiveRuleName
+			unknown bool
+		}
+		var unusedNames []unusedName
+		for _, name := range directive.ruleNames {
+			unknown := isKnownRule != nil && !isKnownRule(name.name)
+			// typescript-eslint rules that tsgolint doesn't implement are still
+			// linted by ESLint
+			if unknown && directive.isESLint {
+				continue
+			}
+			if unknown || !name.used {
+				unusedNames = append(unusedNames, unusedName{name, unknown})
+			}
+		}
+
+		removeWholeComment := len(unusedNames) == len(directive.ruleNames) && !directive.hasOtherRules
+		for _, unused := range unusedNames {
+			msg := buildUnusedRuleMessage(directive.keyword, unused.name.name)
+			if unused.unknown {
+				msg = buildUnknownRuleMessage(unused.name.name)
+			}
+			fix := d.removeRuleName(unused.name)
+			if removeWholeComment {
+				fix = d.removeComment(directive)
+			}
+			report(unused.name.textRange, msg, fix)
+		}
+	}
+
+	return diagnostics
+}
+
+func isHorizontalWhitespace(ch byte) bool {
+	return ch == ' ' || ch == '\t'
+}
+
+// removeComment removes the line of a comment that has a line to itself, and
+// otherwise the comment and the whitespace separating it from code.
+func (d *disableDirectives) removeComment(directive *disableDirective) rule.RuleFix {
+	text := d.file.Text()
+	start, end := directive.comment.Pos(), directive.comment.End()
+	for start > 0 && isHorizontalWhitespace(text[start-1]) {
+		start--
+	}
+	for end < len(text) && isHorizontalWhitespace(text[end]) {
+		end++
+	}
+
+	startsLine := start == 0 || text[start-1] == '\n'
+	endsLine := end == len(text) || text[end] == '\n' || text[end] == '\r'
+	switch {
+	case startsLine && endsLine:
+		if strings.HasPrefix(text[end:], "\r\n") {
+			end += 2
+		} else if end < len(text) {
+			end++
+		}
+	case startsLine:
+		start = directive.comment.Pos()
+	default:
+		end = directive.comment.End()
+	}
+	return rule.RuleFixRemoveRange(core.NewTextRange(start, end))
+}
+
+// removeRuleName removes a name from the list of a directive along with a
+// comma next to it.
+func (d *disableDirectives) removeRuleName(name *directiveRuleName) rule.RuleFix {
+	text := d.file.Text()
+	start, end := name.textRange.Pos(), name.textRange.End()
+
+	before := start
+	for before > 0 && isHorizontalWhitespace(text[before-1]) {
+		before--
+	}
+	if before > 0 && text[before-1] == ',' {
+		return rule.RuleFixRemoveRange(core.NewTextRange(before-1, end))
+	}
+
+	after := end
+	for after < len(text) && isHorizontalWhitespace(text[after]) {
+		after++
+	}
+	if after < len(text) && text[after] == ',' {
+		after++
+		for after < len(text) && isHorizontalWhitespace(text[after]) {
+			after++
+		}
+		return rule.RuleFixRemoveRange(core.NewTextRange(start, after))
+	}
+
+	return rule.RuleFixRemoveRange(name.textRange)
+}
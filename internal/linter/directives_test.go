@@ -0,0 +1,246 @@
+package linter
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/compiler"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/rules/fixtures"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+	"gotest.tools/v3/assert"
+)
+
+func literalRule(name string, kind ast.Kind) ConfiguredRule {
+	return ConfiguredRule{
+		Name: name,
+		Run: func(ctx rule.RuleContext) rule.RuleListeners {
+			return rule.RuleListeners{
+				kind: func(node *ast.Node) {
+					ctx.ReportNode(node, rule.RuleMessage{Id: "literal", Description: "Literal."})
+				},
+			}
+		},
+	}
+}
+
+type reportedLiteral struct {
+	Rule string
+	// 1-based
+	Line int
+}
+
+func getLiteralRules(sourceFile *ast.SourceFile) []ConfiguredRule {
+	return []ConfiguredRule{
+		literalRule("numbers", ast.KindNumericLiteral),
+		literalRule("strings", ast.KindStringLiteral),
+	}
+}
+
+func lintWithDirectives(t *testing.T, code string) []reportedLiteral {
+	t.Helper()
+
+	rootDir := fixtures.GetRootDir()
+	fileName := tspath.ResolvePath(rootDir, "file.ts")
+	fs := utils.NewOverlayVFSForFile(fileName, code)
+	program, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.json", utils.CreateCompilerHost(rootDir, fs))
+	assert.NilError(t, err)
+
+	var reported []reportedLiteral
+	err = RunLinter(
+		program,
+		true,
+		[]*ast.SourceFile{program.GetSourceFile(fileName)},
+		getLiteralRules,
+		Options{},
+		func(d rule.RuleDiagnostic) {
+			line, _ := scanner.GetLineAndCharacterOfPosition(d.SourceFile, d.Range.Pos())
+			reported = append(reported, reportedLiteral{d.RuleName, line + 1})
+		},
+	)
+	assert.NilError(t, err)
+	return reported
+}
+
+func TestDisableDirectives(t *testing.T) {
+	cases := []struct {
+		name     string
+		code     string
+		expected []reportedLiteral
+	}{
+		{
+			name: "no directives",
+			code: "const a = 1;\nconst b = 'b';\n",
+			expected: []reportedLiteral{
+				{"numbers", 1},
+				{"strings", 2},
+			},
+		},
+		{
+			name:     "disable all",
+			code:     "/* tsgolint-disable */\nconst a = 1;\nconst b = 'b';\n",
+			expected: nil,
+		},
+		{
+			name: "disable and enable a rule",
+			code: "/* tsgolint-disable numbers -- generated */\nconst a = 1;\nconst b = 'b';\n/* tsgolint-enable numbers */\nconst c = 3;\n",
+			expected: []reportedLiteral{
+				{"strings", 3},
+				{"numbers", 5},
+			},
+		},
+		{
+			name: "enable a rule after disabling all",
+			code: "/* tsgolint-disable */\n/* tsgolint-enable strings */\nconst a = 1;\nconst b = 'b';\n",
+			expected: []reportedLiteral{
+				{"strings", 4},
+			},
+		},
+		{
+			name: "block directives need block comments",
+			code: "// tsgolint-disable\nconst a = 1;\n",
+			expected: []reportedLiteral{
+				{"numbers", 2},
+			},
+		},
+		{
+			name: "disable line",
+			code: "const a = 1; // tsgolint-disable-line\nconst b = [2, 'b']; /* tsgolint-disable-line strings */\n",
+			expected: []reportedLiteral{
+				{"numbers", 2},
+			},
+		},
+		{
+			name: "disable next line",
+			code: "// tsgolint-disable-next-line numbers, strings\nconst a = [1, 'a'];\nconst b = 2;\n",
+			expected: []reportedLiteral{
+				{"numbers", 3},
+			},
+		},
+		{
+			name: "ESLint directives with typescript-eslint rules",
+			code: "// eslint-disable-next-line no-console, @typescript-eslint/numbers\nconst a = [1, 'a'];\n",
+			expected: []reportedLiteral{
+				{"strings", 2},
+			},
+		},
+		{
+			name: "ESLint directives for other rules",
+			code: "// eslint-disable-next-line no-console\nconst a = 1;\n",
+			expected: []reportedLiteral{
+				{"numbers", 2},
+			},
+		},
+		{
+			name:     "ESLint directives for all rules",
+			code:     "const a = 1; // eslint-disable-line\n",
+			expected: nil,
+		},
+		{
+			name: "directives in strings and templates",
+			code: "const a = '// tsgolint-disable-line';\nconst b = `${1} /* tsgolint-disable */`;\nconst c = 3;\n",
+			expected: []reportedLiteral{
+				{"strings", 1},
+				{"numbers", 2},
+				{"numbers", 3},
+			},
+		},
+		{
+			name:     "directives inside expressions",
+			code:     "foo(\n  // tsgolint-disable-next-line\n  1,\n);\ndeclare function foo(a: number): void;\n",
+			expected: nil,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.DeepEqual(t, lintWithDirectives(t, c.code), c.expected)
+		})
+	}
+}
+
+func TestUnusedDisableDirectives(t *testing.T) {
+	cases := []struct {
+		name     string
+		code     string
+		messages []string
+		output   string
+	}{
+		{
+			name:     "used directives",
+			code:     "const a = 1; // tsgolint-disable-line numbers\n/* tsgolint-disable strings */\nconst b = 'b';\n",
+			messages: nil,
+			output:   "const a = 1; // tsgolint-disable-line numbers\n/* tsgolint-disable strings */\nconst b = 'b';\n",
+		},
+		{
+			name:     "unused directive",
+			code:     "const a = 1; // tsgolint-disable-line numbers\n  // tsgolint-disable-next-line\nconst b = a;\n",
+			messages: []string{"Unused tsgolint-disable-next-line directive (no problems were reported)."},
+			output:   "const a = 1; // tsgolint-disable-line numbers\nconst b = a;\n",
+		},
+		{
+			name:     "unused trailing directive",
+			code:     "const a = 1;\nconst b = a; /* tsgolint-disable-line numbers -- old */\n",
+			messages: []string{"Unused tsgolint-disable-line directive (no problems were reported from 'numbers')."},
+			output:   "const a = 1;\nconst b = a;\n",
+		},
+		{
+			name: "unused and unknown rule names",
+			code: "// tsgolint-disable-next-line strings, numbers, no-such-rule\nconst a = 1;\n",
+			messages: []string{
+				"Unused tsgolint-disable-next-line directive (no problems were reported from 'strings').",
+				"Definition for rule 'no-such-rule' was not found.",
+			},
+			output: "// tsgolint-disable-next-line numbers\nconst a = 1;\n",
+		},
+		{
+			name: "ESLint directives",
+			code: "// eslint-disable-next-line no-console, @typescript-eslint/strings, @typescript-eslint/no-unused-vars\nconst a = 1; // eslint-disable-line\n",
+			messages: []string{
+				"Unused eslint-disable-next-line directive (no problems were reported from 'strings').",
+			},
+			output: "// eslint-disable-next-line no-console, @typescript-eslint/no-unused-vars\nconst a = 1; // eslint-disable-line\n",
+		},
+	}
+
+	rootDir := fixtures.GetRootDir()
+	fileName := tspath.ResolvePath(rootDir, "file.ts")
+	options := Options{
+		ReportUnusedDirectives: true,
+		IsKnownRule: func(name string) bool {
+			return name == "numbers" || name == "strings"
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			createProgram := func(overlay map[string]string) (*compiler.Program, error) {
+				fs := utils.NewOverlayVFSForFile(fileName, c.code)
+				fs = utils.NewOverlayVFS(fs, overlay)
+				return utils.CreateProgram(true, fs, rootDir, "tsconfig.json", utils.CreateCompilerHost(rootDir, fs))
+			}
+			program, err := createProgram(nil)
+			assert.NilError(t, err)
+			files := []*ast.SourceFile{program.GetSourceFile(fileName)}
+
+			var messages []string
+			err = RunLinter(program, true, files, getLiteralRules, options, func(d rule.RuleDiagnostic) {
+				if d.RuleName == UnusedDirectiveRuleName {
+					messages = append(messages, d.Message.Description)
+				}
+			})
+			assert.NilError(t, err)
+			assert.DeepEqual(t, messages, c.messages)
+
+			result, err := RunLinterWithFixes(program, createProgram, true, files, getLiteralRules, options, rule.RuleDiagnostic.Fixes)
+			assert.NilError(t, err)
+			output, ok := result.FixedFiles[fileName]
+			if !ok {
+				output = c.code
+			}
+			assert.Equal(t, output, c.output)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package linter
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/compiler"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+)
+
+// Fixes can produce code that has fixable problems itself, so files are re-linted
+// after applying them. Like ESLint, stop after this many passes.
+const MaxFixPasses = 10
+
+type FixResult struct {
+	// Fixed text by file name. Only contains files that were changed
+	FixedFiles map[string]string
+	// Problems left after fixing, in the order of the linted files
+	Diagnostics []rule.RuleDiagnostic
+	// Number of problems fixed across all passes
+	FixedCount int
+}
+
+type selectedFixes struct {
+	diagnostic rule.RuleDiagnostic
+	fixes      []rule.RuleFix
+}
+
+func (s selectedFixes) Fixes() []rule.RuleFix {
+	return s.fixes
+}
+
+// RunLinterWithFixes lints files, applies the fixes picked by selectFixes and
+// re-lints the changed files and the files importing them against a program
+// created by createProgram with the fixed text overlaid, until nothing changes
+// or MaxFixPasses is reached. Fixes that leave a file unparsable are dropped,
+// and the file keeps its last valid text and its problems. Nothing is written
+// to disk.
+func RunLinterWithFixes(
+	program *compiler.Program,
+	createProgram func(overlay map[string]string) (*compiler.Program, error),
+	singleThreaded bool,
+	files []*ast.SourceFile,
+	getRulesForFile func(sourceFile *ast.SourceFile) []ConfiguredRule,
+	options Options,
+	selectFixes func(diagnostic rule.RuleDiagnostic) []rule.RuleFix,
+) (FixResult, error) {
+	result := FixResult{FixedFiles: map[string]string{}}
+
+	diagnosticsByFile := make(map[string][]rule.RuleDiagnostic, len(files))
+	var diagnosticsMu sync.Mutex
+
+	linted := make(map[string]struct{}, len(files))
+	for _, file := range files {
+		linted[file.FileName()] = struct{}{}
+	}
+
+	filesToLint := files
+	for pass := 0; ; pass++ {
+		for _, file := range filesToLint {
+			diagnosticsByFile[file.FileName()] = nil
+		}
+		err := RunLinter(program, singleThreaded, filesToLint, getRulesForFile, options, func(d rule.RuleDiagnostic) {
+			diagnosticsMu.Lock()
+			defer diagnosticsMu.Unlock()
+			diagnosticsByFile[d.SourceFile.FileName()] = append(diagnosticsByFile[d.SourceFile.FileName()], d)
+		})
+		if err != nil {
+			return result, err
+		}
+		if pass == MaxFixPasses {
+			break
+		}
+
+		changedFiles := []string{}
+		// to undo the pass for files it breaks
+		previousTexts := map[string]string{}
+		fixedCounts := map[string]int{}
+		for _, file := range filesToLint {
+			messages := make([]selectedFixes, 0, len(diagnosticsByFile[file.FileName()]))
+			withFixes := 0
+			for _, d := range diagnosticsByFile[file.FileName()] {
+				fixes := selectFixes(d)
+				if len(fixes) > 0 {
+					withFixes++
+				}
+				messages = append(messages, selectedFixes{d, fixes})
+			}
+			if withFixes == 0 {
+				continue
+			}
+
+			fixedText, unapplied, fixed := ApplyRuleFixes(file.Text(), messages)
+			if !fixed {
+				continue
+			}
+			for _, m := range unapplied {
+				if len(m.fixes) > 0 {
+					withFixes--
+				}
+			}
+			if previousText, ok := result.FixedFiles[file.FileName()]; ok {
+				previousTexts[file.FileName()] = previousText
+			}
+			fixedCounts[file.FileName()] = withFixes
+			result.FixedCount += withFixes
+			result.FixedFiles[file.FileName()] = fixedText
+			changedFiles = append(changedFiles, file.FileName())
+		}
+		if len(changedFiles) == 0 {
+			break
+		}
+
+		program, err = createProgram(result.FixedFiles)
+		if err != nil {
+			return result, fmt.Errorf("creating program for fix pass %v: %w", pass+1, err)
+		}
+		validFileNames := make([]string, 0, len(changedFiles))
+		for _, fileName := range changedFiles {
+			file := program.GetSourceFile(fileName)
+			if file == nil {
+				return result, fmt.Errorf("fixed file %v is missing from the program", fileName)
+			}
+			if len(getParseErrors(program, file)) == 0 {
+				validFileNames = append(validFileNames, fileName)
+				continue
+			}
+			if previousText, ok := previousTexts[fileName]; ok {
+				result.FixedFiles[fileName] = previousText
+			} else {
+				delete(result.FixedFiles, fileName)
+			}
+			result.FixedCount -= fixedCounts[fileName]
+		}
+		if len(validFileNames) < len(changedFiles) {
+			program, err = createProgram(result.FixedFiles)
+			if err != nil {
+				return result, fmt.Errorf("creating program for fix pass %v: %w", pass+1, err)
+			}
+		}
+		if len(validFileNames) == 0 {
+			break
+		}
+
+		// fixes can change types in the files importing the fixed ones
+		validFiles := make([]*ast.SourceFile, len(validFileNames))
+		for i, fileName := range validFileNames {
+			validFiles[i] = program.GetSourceFile(fileName)
+		}
+		filesToLint = nil
+		for _, file := range utils.GetDependents(program, validFiles) {
+			if _, ok := linted[file.FileName()]; ok {
+				filesToLint = append(filesToLint, file)
+			}
+		}
+	}
+
+	for _, file := range files {
+		result.Diagnostics = append(result.Diagnostics, diagnosticsByFile[file.FileName()]...)
+	}
+	return result, nil
+}
@@ -0,0 +1,179 @@
+package linter
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/compiler"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/rules/fixtures"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+	"gotest.tools/v3/assert"
+)
+
+// Increments every numeric literal below 3, one step per fix pass
+var incrementRule = ConfiguredRule{
+	Name: "increment",
+	Run: func(ctx rule.RuleContext) rule.RuleListeners {
+		return rule.RuleListeners{
+			ast.KindNumericLiteral: func(node *ast.Node) {
+				next := map[string]string{"1": "2", "2": "3"}[node.Text()]
+				if next == "" {
+					ctx.ReportNode(node, rule.RuleMessage{Id: "big", Description: "Big number."})
+					return
+				}
+				ctx.ReportNodeWithFixes(node, rule.RuleMessage{Id: "small", Description: "Small number."}, rule.RuleFix{
+					Text:  next,
+					Range: utils.TrimNodeTextRange(ctx.SourceFile, node),
+				})
+			},
+		}
+	},
+}
+
+func TestRunLinterWithFixes(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	fileName := tspath.ResolvePath(rootDir, "file.ts")
+
+	createProgram := func(overlay map[string]string) (*compiler.Program, error) {
+		fs := utils.NewOverlayVFSForFile(fileName, "const a = 1;\nconst b = [2, 4];\n")
+		fs = utils.NewOverlayVFS(fs, overlay)
+		return utils.CreateProgram(true, fs, rootDir, "tsconfig.json", utils.CreateCompilerHost(rootDir, fs))
+	}
+
+	program, err := createProgram(nil)
+	assert.NilError(t, err)
+
+	result, err := RunLinterWithFixes(
+		program,
+		createProgram,
+		true,
+		[]*ast.SourceFile{program.GetSourceFile(fileName)},
+		func(sourceFile *ast.SourceFile) []ConfiguredRule {
+			return []ConfiguredRule{incrementRule}
+		},
+		Options{},
+		rule.RuleDiagnostic.Fixes,
+	)
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, result.FixedFiles, map[string]string{fileName: "const a = 3;\nconst b = [3, 4];\n"})
+	assert.Equal(t, result.FixedCount, 3)
+	assert.Equal(t, len(result.Diagnostics), 3)
+	for _, d := range result.Diagnostics {
+		assert.Equal(t, d.Message.Id, "big")
+		assert.Equal(t, d.SourceFile.Text(), result.FixedFiles[fileName])
+	}
+}
+
+// newFixProgram creates programs of files with the fixed text overlaid, like
+// the CLI does between fix passes.
+func newFixProgram(t *testing.T, files map[string]string) (*compiler.Program, func(overlay map[string]string) (*compiler.Program, error)) {
+	t.Helper()
+
+	rootDir := fixtures.GetRootDir()
+	virtualFiles := map[string]string{}
+	for name, text := range files {
+		virtualFiles[tspath.ResolvePath(rootDir, name)] = text
+	}
+	createProgram := func(overlay map[string]string) (*compiler.Program, error) {
+		fs := utils.NewOverlayVFS(utils.NewOverlayVFSForFile(tspath.ResolvePath(rootDir, "file.ts"), "export {};\n"), virtualFiles)
+		fs = utils.NewOverlayVFS(fs, overlay)
+		return utils.CreateProgram(true, fs, rootDir, "tsconfig.json", utils.CreateCompilerHost(rootDir, fs))
+	}
+	program, err := createProgram(nil)
+	assert.NilError(t, err)
+	return program, createProgram
+}
+
+func TestRunLinterWithFixesDropsFixesBreakingFiles(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	program, createProgram := newFixProgram(t, map[string]string{
+		"good.ts": "export const a = 1;\n",
+		"bad.ts":  "export const s = 'x';\n",
+	})
+	// fixes every string into a syntax error
+	breakRule := ConfiguredRule{
+		Name: "break",
+		Run: func(ctx rule.RuleContext) rule.RuleListeners {
+			return rule.RuleListeners{
+				ast.KindStringLiteral: func(node *ast.Node) {
+					ctx.ReportNodeWithFixes(node, rule.RuleMessage{Id: "string", Description: "String."}, rule.RuleFix{
+						Text:  "(",
+						Range: utils.TrimNodeTextRange(ctx.SourceFile, node),
+					})
+				},
+			}
+		},
+	}
+
+	goodFileName := tspath.ResolvePath(rootDir, "good.ts")
+	badFileName := tspath.ResolvePath(rootDir, "bad.ts")
+	result, err := RunLinterWithFixes(
+		program,
+		createProgram,
+		true,
+		[]*ast.SourceFile{program.GetSourceFile(goodFileName), program.GetSourceFile(badFileName)},
+		func(sourceFile *ast.SourceFile) []ConfiguredRule {
+			return []ConfiguredRule{incrementRule, breakRule}
+		},
+		Options{},
+		rule.RuleDiagnostic.Fixes,
+	)
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, result.FixedFiles, map[string]string{goodFileName: "export const a = 3;\n"})
+	assert.Equal(t, result.FixedCount, 2)
+	assert.Equal(t, len(result.Diagnostics), 2)
+	assert.Equal(t, result.Diagnostics[0].Message.Id, "big")
+	assert.Equal(t, result.Diagnostics[1].Message.Id, "string")
+	assert.Equal(t, result.Diagnostics[1].SourceFile.Text(), "export const s = 'x';\n")
+}
+
+func TestRunLinterWithFixesLintsDependentsAgain(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	program, createProgram := newFixProgram(t, map[string]string{
+		"imported.ts": "export const a = 1;\n",
+		"importer.ts": "import { a } from './imported';\nexport const b = a;\n",
+	})
+	// reports the type of the exported b, which comes from imported.ts
+	typeRule := ConfiguredRule{
+		Name: "type",
+		Run: func(ctx rule.RuleContext) rule.RuleListeners {
+			return rule.RuleListeners{
+				ast.KindVariableDeclaration: func(node *ast.Node) {
+					name := node.Name()
+					if name.Text() != "b" {
+						return
+					}
+					nameType := ctx.TypeChecker.GetTypeAtLocation(name)
+					ctx.ReportNode(name, rule.RuleMessage{Id: "type", Description: ctx.TypeChecker.TypeToString(nameType)})
+				},
+			}
+		},
+	}
+
+	importedFileName := tspath.ResolvePath(rootDir, "imported.ts")
+	importerFileName := tspath.ResolvePath(rootDir, "importer.ts")
+	result, err := RunLinterWithFixes(
+		program,
+		createProgram,
+		true,
+		[]*ast.SourceFile{program.GetSourceFile(importedFileName), program.GetSourceFile(importerFileName)},
+		func(sourceFile *ast.SourceFile) []ConfiguredRule {
+			if sourceFile.FileName() == importedFileName {
+				return []ConfiguredRule{incrementRule}
+			}
+			return []ConfiguredRule{typeRule}
+		},
+		Options{},
+		rule.RuleDiagnostic.Fixes,
+	)
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, result.FixedFiles, map[string]string{importedFileName: "export const a = 3;\n"})
+	assert.Equal(t, len(result.Diagnostics), 2)
+	assert.Equal(t, result.Diagnostics[1].SourceFile.FileName(), importerFileName)
+	assert.Equal(t, result.Diagnostics[1].Message.Description, "3")
+}
@@ -2,53 +2,140 @@ package linter
 
 import (
 	"context"
+	"time"
 
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 
 	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/checker"
 	"github.com/microsoft/typescript-go/shim/compiler"
 	"github.com/microsoft/typescript-go/shim/core"
 )
 
 type ConfiguredRule struct {
-	Name string
-	Run  func(ctx rule.RuleContext) rule.RuleListeners
+	Name     string
+	Severity rule.DiagnosticSeverity
+	Run      func(ctx rule.RuleContext) rule.RuleListeners
 }
 
-func RunLinter(program *compiler.Program, singleThreaded bool, files []*ast.SourceFile, getRulesForFile func(sourceFile *ast.SourceFile) []ConfiguredRule, onDiagnostic func(diagnostic rule.RuleDiagnostic)) error {
+type Options struct {
+	// Report disable directives that suppress nothing or name unknown rules
+	ReportUnusedDirectives bool
+	// Tells which rule names in directives exist. All names are known if nil
+	IsKnownRule func(name string) bool
+	// Also report the TS type errors of the linted files
+	TypeCheck bool
+	// Measures the rules and type checking if not nil
+	Timing *Timing
+	// Records the work done for each file if not nil
+	Stats *Stats
+}
+
+// ParseErrorRuleName is the rule name of the diagnostics reported for syntax
+// errors. Rules don't run on files with syntax errors, but the rest of the
+// program is linted as usual.
+const ParseErrorRuleName = "parse-error"
+
+func getParseErrors(program *compiler.Program, file *ast.SourceFile) []rule.RuleDiagnostic {
+	syntacticDiagnostics := program.GetSyntacticDiagnostics(context.Background(), file)
+	return utils.Map(syntacticDiagnostics, func(diagnostic *ast.Diagnostic) rule.RuleDiagnostic {
+		return rule.RuleDiagnostic{
+			RuleName: ParseErrorRuleName,
+			Range:    diagnostic.Loc(),
+			Message: rule.RuleMessage{
+				Id:          "parseError",
+				Description: diagnostic.Message(),
+			},
+			SourceFile: file,
+		}
+	})
+}
 
-	queue := make(chan *ast.SourceFile, len(files))
-	for _, file := range files {
-		queue <- file
+func RunLinter(program *compiler.Program, singleThreaded bool, files []*ast.SourceFile, getRulesForFile func(sourceFile *ast.SourceFile) []ConfiguredRule, options Options, onDiagnostic func(diagnostic rule.RuleDiagnostic)) error {
+	newQueue := func(files []*ast.SourceFile) chan *ast.SourceFile {
+		queue := make(chan *ast.SourceFile, len(files))
+		for _, file := range files {
+			queue <- file
+		}
+		close(queue)
+		return queue
 	}
-	close(queue)
 
 	wg := core.NewWorkGroup(singleThreaded)
 	checkers, done := program.GetTypeCheckers(context.Background())
 	defer done()
-	for _, checker := range checkers {
+
+	queues := make([]chan *ast.SourceFile, len(checkers))
+	if options.TypeCheck {
+		// the program type checks a file with the checker it is assigned to,
+		// so lint it with that checker too, and it is only checked once
+		filesByChecker := make(map[*checker.Checker][]*ast.SourceFile, len(checkers))
+		for _, file := range files {
+			fileChecker, release := program.GetTypeCheckerForFile(context.Background(), file)
+			release()
+			filesByChecker[fileChecker] = append(filesByChecker[fileChecker], file)
+		}
+		for i, checker := range checkers {
+			queues[i] = newQueue(filesByChecker[checker])
+		}
+	} else {
+		queue := newQueue(files)
+		for i := range queues {
+			queues[i] = queue
+		}
+	}
+
+	for i, checker := range checkers {
+		queue := queues[i]
 		wg.Queue(func() {
 			registeredListeners := make(map[ast.Kind][](func(node *ast.Node)), 20)
+			var ruleTimes map[string]time.Duration
+			if options.Timing != nil {
+				ruleTimes = map[string]time.Duration{}
+				defer options.Timing.addRules(ruleTimes)
+			}
 
 			for file := range queue {
+				fileStats := FileStats{FileName: file.FileName()}
+				onFileDiagnostic := func(diagnostic rule.RuleDiagnostic) {
+					fileStats.Diagnostics++
+					onDiagnostic(diagnostic)
+				}
+
+				if parseErrors := getParseErrors(program, file); len(parseErrors) > 0 {
+					for _, d := range parseErrors {
+						onFileDiagnostic(d)
+					}
+					options.Stats.add(fileStats)
+					continue
+				}
+
 				rules := getRulesForFile(file)
+				directives := parseDisableDirectives(file)
+				report := func(diagnostic rule.RuleDiagnostic) {
+					if !directives.suppress(diagnostic) {
+						onFileDiagnostic(diagnostic)
+					}
+				}
 				for _, r := range rules {
 					ctx := rule.RuleContext{
 						SourceFile:  file,
 						Program:     program,
 						TypeChecker: checker,
 						ReportRange: func(textRange core.TextRange, msg rule.RuleMessage) {
-							onDiagnostic(rule.RuleDiagnostic{
+							report(rule.RuleDiagnostic{
 								RuleName:   r.Name,
+								Severity:   r.Severity,
 								Range:      textRange,
 								Message:    msg,
 								SourceFile: file,
 							})
 						},
 						ReportRangeWithSuggestions: func(textRange core.TextRange, msg rule.RuleMessage, suggestions ...rule.RuleSuggestion) {
-							onDiagnostic(rule.RuleDiagnostic{
+							report(rule.RuleDiagnostic{
 								RuleName:    r.Name,
+								Severity:    r.Severity,
 								Range:       textRange,
 								Message:     msg,
 								Suggestions: &suggestions,
@@ -56,16 +143,18 @@ func RunLinter(program *compiler.Program, singleThreaded bool, files []*ast.Sour
 							})
 						},
 						ReportNode: func(node *ast.Node, msg rule.RuleMessage) {
-							onDiagnostic(rule.RuleDiagnostic{
+							report(rule.RuleDiagnostic{
 								RuleName:   r.Name,
+								Severity:   r.Severity,
 								Range:      utils.TrimNodeTextRange(file, node),
 								Message:    msg,
 								SourceFile: file,
 							})
 						},
 						ReportNodeWithFixes: func(node *ast.Node, msg rule.RuleMessage, fixes ...rule.RuleFix) {
-							onDiagnostic(rule.RuleDiagnostic{
+							report(rule.RuleDiagnostic{
 								RuleName:   r.Name,
+								Severity:   r.Severity,
 								Range:      utils.TrimNodeTextRange(file, node),
 								Message:    msg,
 								FixesPtr:   &fixes,
@@ -74,8 +163,9 @@ func RunLinter(program *compiler.Program, singleThreaded bool, files []*ast.Sour
 						},
 
 						ReportNodeWithSuggestions: func(node *ast.Node, msg rule.RuleMessage, suggestions ...rule.RuleSuggestion) {
-							onDiagnostic(rule.RuleDiagnostic{
+							report(rule.RuleDiagnostic{
 								RuleName:    r.Name,
+								Severity:    r.Severity,
 								Range:       utils.TrimNodeTextRange(file, node),
 								Message:     msg,
 								Suggestions: &suggestions,
@@ -84,7 +174,24 @@ func RunLinter(program *compiler.Program, singleThreaded bool, files []*ast.Sour
 						},
 					}
 
-					for kind, listener := range r.Run(ctx) {
+					var start time.Time
+					if ruleTimes != nil {
+						start = time.Now()
+					}
+					ruleListeners := r.Run(ctx)
+					if ruleTimes != nil {
+						ruleTimes[r.Name] += time.Since(start)
+					}
+
+					for kind, listener := range ruleListeners {
+						if ruleTimes != nil {
+							run := listener
+							listener = func(node *ast.Node) {
+								start := time.Now()
+								run(node)
+								ruleTimes[r.Name] += time.Since(start)
+							}
+						}
 						listeners, ok := registeredListeners[kind]
 						if !ok {
 							listeners = make([](func(node *ast.Node)), 0, len(rules))
@@ -95,9 +202,16 @@ func RunLinter(program *compiler.Program, singleThreaded bool, files []*ast.Sour
 
 				runListeners := func(kind ast.Kind, node *ast.Node) {
 					if listeners, ok := registeredListeners[kind]; ok {
+						var start time.Time
+						if options.Stats != nil {
+							start = time.Now()
+						}
 						for _, listener := range listeners {
 							listener(node)
 						}
+						if options.Stats != nil {
+							fileStats.ListenersTime += time.Since(start)
+						}
 					}
 				}
 
@@ -118,6 +232,7 @@ func RunLinter(program *compiler.Program, singleThreaded bool, files []*ast.Sour
 				var childVisitor ast.Visitor
 				var patternVisitor func(node *ast.Node)
 				patternVisitor = func(node *ast.Node) {
+					fileStats.Nodes++
 					runListeners(node.Kind, node)
 					kind := rule.ListenerOnAllowPattern(node.Kind)
 					runListeners(kind, node)
@@ -143,6 +258,7 @@ func RunLinter(program *compiler.Program, singleThreaded bool, files []*ast.Sour
 					runListeners(rule.ListenerOnExit(node.Kind), node)
 				}
 				childVisitor = func(node *ast.Node) bool {
+					fileStats.Nodes++
 					runListeners(node.Kind, node)
 
 					switch node.Kind {
@@ -168,10 +284,45 @@ func RunLinter(program *compiler.Program, singleThreaded bool, files []*ast.Sour
 				}
 				file.Node.ForEachChild(childVisitor)
 				clear(registeredListeners)
+
+				if options.ReportUnusedDirectives {
+					for _, d := range directives.unused(options.IsKnownRule) {
+						onFileDiagnostic(d)
+					}
+				}
+				if options.TypeCheck {
+					start := time.Now()
+					// the result is thrown away: this only type checks the file
+					// in parallel, so that the diagnostics are cached for
+					// getSemanticDiagnostics below
+					checker.GetDiagnostics(context.Background(), file)
+					fileStats.TypeCheckTime = time.Since(start)
+					options.Timing.AddPhase(PhaseTypeChecking, fileStats.TypeCheckTime)
+				}
+				options.Stats.add(fileStats)
 			}
 		})
 	}
 	wg.RunAndWait()
 
+	// the files were type checked by the workers, and that time is already
+	// counted, so this only reads the cached diagnostics
+	if options.TypeCheck {
+		for _, file := range files {
+			if len(file.Diagnostics()) > 0 {
+				// already reported as parse errors
+				continue
+			}
+			diagnostics := getSemanticDiagnostics(program, file)
+			options.Stats.add(FileStats{
+				FileName:    file.FileName(),
+				Diagnostics: len(diagnostics),
+			})
+			for _, d := range diagnostics {
+				onDiagnostic(d)
+			}
+		}
+	}
+
 	return nil
 }
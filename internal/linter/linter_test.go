@@ -0,0 +1,127 @@
+package linter
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/rules/fixtures"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+	"gotest.tools/v3/assert"
+)
+
+type testFile struct {
+	name string
+	text string
+}
+
+type reportedProblem struct {
+	File string
+	Rule string
+	// 1-based
+	Line int
+}
+
+// lintFiles lints files, in their order, with getLiteralRules and returns the
+// problems in the order they were reported.
+func lintFiles(t *testing.T, options Options, files ...testFile) []reportedProblem {
+	t.Helper()
+
+	rootDir := fixtures.GetRootDir()
+	virtualFiles := make(map[string]string, len(files))
+	for _, file := range files {
+		virtualFiles[tspath.ResolvePath(rootDir, file.name)] = file.text
+	}
+	fs := utils.NewOverlayVFS(utils.NewOverlayVFSForFile(tspath.ResolvePath(rootDir, files[0].name), files[0].text), virtualFiles)
+	program, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.json", utils.CreateCompilerHost(rootDir, fs))
+	assert.NilError(t, err)
+
+	var reported []reportedProblem
+	err = RunLinter(
+		program,
+		true,
+		utils.Map(files, func(file testFile) *ast.SourceFile {
+			return program.GetSourceFile(tspath.ResolvePath(rootDir, file.name))
+		}),
+		getLiteralRules,
+		options,
+		func(d rule.RuleDiagnostic) {
+			line, _ := scanner.GetLineAndCharacterOfPosition(d.SourceFile, d.Range.Pos())
+			reported = append(reported, reportedProblem{tspath.GetBaseFileName(d.SourceFile.FileName()), d.RuleName, line + 1})
+		},
+	)
+	assert.NilError(t, err)
+	return reported
+}
+
+func TestParseErrors(t *testing.T) {
+	reported := lintFiles(
+		t,
+		Options{},
+		testFile{"broken.ts", "const b = 2;\nconst c = (;\n"},
+		testFile{"file.ts", "const a = 1;\n"},
+	)
+	assert.DeepEqual(t, reported, []reportedProblem{
+		{"broken.ts", ParseErrorRuleName, 2},
+		{"file.ts", "numbers", 1},
+	})
+}
+
+func TestTypeCheck(t *testing.T) {
+	reported := lintFiles(
+		t,
+		Options{TypeCheck: true},
+		testFile{"file.ts", "const a: string = 1;\n// @ts-expect-error\nconst b: string = 2;\n"},
+	)
+	assert.DeepEqual(t, reported, []reportedProblem{
+		{"file.ts", "numbers", 1},
+		{"file.ts", "numbers", 3},
+		{"file.ts", "ts(2322)", 1},
+	})
+}
+
+func TestTiming(t *testing.T) {
+	timing := NewTiming()
+	stats := NewStats()
+	lintFiles(t, Options{TypeCheck: true, Timing: timing, Stats: stats}, testFile{"file.ts", "const a: string = 1;\n"})
+
+	names := utils.Map(timing.Rules(), func(r RuleTiming) string {
+		return r.Name
+	})
+	slices.Sort(names)
+	assert.DeepEqual(t, names, []string{"numbers", "strings"})
+	assert.Assert(t, timing.Phase(PhaseTypeChecking) > 0)
+	// type checking is counted once, per file
+	assert.Equal(t, timing.Phase(PhaseTypeChecking), stats.Files()[0].TypeCheckTime)
+	assert.Equal(t, timing.Phase(PhaseBinding), time.Duration(0))
+}
+
+func TestStats(t *testing.T) {
+	stats := NewStats()
+	lintFiles(
+		t,
+		Options{TypeCheck: true, Stats: stats},
+		testFile{"broken.ts", "const c = (;\n"},
+		testFile{"file.ts", "const a: string = 1;\nconst b = 'b';\n"},
+	)
+
+	files := stats.Files()
+	slices.SortFunc(files, func(a FileStats, b FileStats) int {
+		return strings.Compare(a.FileName, b.FileName)
+	})
+	assert.Equal(t, len(files), 2)
+	assert.Equal(t, tspath.GetBaseFileName(files[0].FileName), "broken.ts")
+	assert.Equal(t, files[0].Diagnostics, 1)
+	assert.Equal(t, files[0].Nodes, 0)
+	assert.Equal(t, tspath.GetBaseFileName(files[1].FileName), "file.ts")
+	// the numeric and string literals, and the TS error
+	assert.Equal(t, files[1].Diagnostics, 3)
+	assert.Assert(t, files[1].Nodes > 0)
+	assert.Assert(t, files[1].ListenersTime > 0)
+	assert.Assert(t, files[1].TypeCheckTime > 0)
+}
@@ -0,0 +1,66 @@
+package linter
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+)
+
+type FileStats struct {
+	FileName string
+	// Spent in rule listeners, including the types they ask for
+	ListenersTime time.Duration
+	// Spent getting the TS diagnostics of --type-check
+	TypeCheckTime time.Duration
+	Nodes         int
+	Diagnostics   int
+}
+
+func (s FileStats) Time() time.Duration {
+	return s.ListenersTime + s.TypeCheckTime
+}
+
+// Stats records how much work each linted file took, to find the files that
+// slow linting down. A nil Stats records nothing.
+type Stats struct {
+	mu    sync.Mutex
+	files map[string]FileStats
+}
+
+func NewStats() *Stats {
+	return &Stats{files: map[string]FileStats{}}
+}
+
+// add sums up the stats of a file with what was recorded for it before.
+func (s *Stats) add(stats FileStats) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	current := s.files[stats.FileName]
+	current.FileName = stats.FileName
+	current.ListenersTime += stats.ListenersTime
+	current.TypeCheckTime += stats.TypeCheckTime
+	current.Nodes += stats.Nodes
+	current.Diagnostics += stats.Diagnostics
+	s.files[stats.FileName] = current
+}
+
+// Files returns the stats of every linted file, slowest first.
+func (s *Stats) Files() []FileStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	files := make([]FileStats, 0, len(s.files))
+	for _, stats := range s.files {
+		files = append(files, stats)
+	}
+	slices.SortFunc(files, func(a FileStats, b FileStats) int {
+		if c := cmp.Compare(b.Time(), a.Time()); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.FileName, b.FileName)
+	})
+	return files
+}
@@ -0,0 +1,87 @@
+package linter
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+)
+
+type Phase string
+
+const (
+	PhaseProgramCreation Phase = "programCreation"
+	PhaseBinding         Phase = "binding"
+	// Only the TS diagnostics of --type-check. Types that rules ask for are
+	// computed lazily, and that time counts toward the rules.
+	PhaseTypeChecking Phase = "typeChecking"
+)
+
+var Phases = []Phase{PhaseProgramCreation, PhaseBinding, PhaseTypeChecking}
+
+type RuleTiming struct {
+	Name string
+	Time time.Duration
+}
+
+// Timing sums up where linting spends its time. Work done in parallel is
+// summed across the worker goroutines, so the times can add up to more than
+// the wall time. A nil Timing measures nothing, so that callers don't need to
+// check whether timing is on.
+type Timing struct {
+	mu     sync.Mutex
+	phases map[Phase]time.Duration
+	rules  map[string]time.Duration
+}
+
+func NewTiming() *Timing {
+	return &Timing{
+		phases: map[Phase]time.Duration{},
+		rules:  map[string]time.Duration{},
+	}
+}
+
+func (t *Timing) AddPhase(phase Phase, d time.Duration) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.phases[phase] += d
+}
+
+// addRules merges the rule times of a worker, which are kept apart while
+// linting so that the workers don't contend for the lock.
+func (t *Timing) addRules(rules map[string]time.Duration) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for name, d := range rules {
+		t.rules[name] += d
+	}
+}
+
+func (t *Timing) Phase(phase Phase) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.phases[phase]
+}
+
+// Rules returns the time spent in each rule, slowest first.
+func (t *Timing) Rules() []RuleTiming {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	rules := make([]RuleTiming, 0, len(t.rules))
+	for name, d := range t.rules {
+		rules = append(rules, RuleTiming{name, d})
+	}
+	slices.SortFunc(rules, func(a RuleTiming, b RuleTiming) int {
+		if c := cmp.Compare(b.Time, a.Time); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return rules
+}
@@ -0,0 +1,87 @@
+package linter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/compiler"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+)
+
+// TypeCheckRuleName is the rule name TS diagnostics are reported under, like
+// ts(2322), so that they can be told apart in every output format.
+func TypeCheckRuleName(code int32) string {
+	return fmt.Sprintf("ts(%v)", code)
+}
+
+func writeDiagnosticMessage(b *strings.Builder, diagnostic *ast.Diagnostic, indent int) {
+	if indent > 0 {
+		b.WriteByte('\n')
+		b.WriteString(strings.Repeat("  ", indent))
+	}
+	b.WriteString(diagnostic.Message())
+	for _, chained := range diagnostic.MessageChain() {
+		writeDiagnosticMessage(b, chained, indent+1)
+	}
+}
+
+// convertTSDiagnostic turns a TS diagnostic into a lint problem. Diagnostics
+// without a file are put at the start of fallbackFile.
+func convertTSDiagnostic(diagnostic *ast.Diagnostic, fallbackFile *ast.SourceFile) rule.RuleDiagnostic {
+	file := diagnostic.File()
+	textRange := diagnostic.Loc()
+	if file == nil {
+		file = fallbackFile
+		textRange = core.NewTextRange(0, 0)
+	}
+	severity := rule.SeverityError
+	if diagnostic.Category().Name() == "warning" {
+		severity = rule.SeverityWarning
+	}
+
+	var message strings.Builder
+	writeDiagnosticMessage(&message, diagnostic, 0)
+	return rule.RuleDiagnostic{
+		RuleName: TypeCheckRuleName(diagnostic.Code()),
+		Severity: severity,
+		Range:    textRange,
+		Message: rule.RuleMessage{
+			Id:          "typeCheck",
+			Description: message.String(),
+		},
+		SourceFile: file,
+	}
+}
+
+// getSemanticDiagnostics returns the type errors of a file. It should be
+// called after the file's checker is done with it, since reading the
+// diagnostics touches every checker of the program.
+func getSemanticDiagnostics(program *compiler.Program, file *ast.SourceFile) []rule.RuleDiagnostic {
+	var diagnostics []rule.RuleDiagnostic
+	for _, diagnostic := range program.GetSemanticDiagnostics(context.Background(), file) {
+		diagnostics = append(diagnostics, convertTSDiagnostic(diagnostic, file))
+	}
+	return diagnostics
+}
+
+// GetGlobalDiagnostics returns the TS diagnostics that don't belong to a
+// linted file: problems with the compiler options, missing files and global
+// types. Those without a location are put at the start of configFile, or left
+// out if it is nil.
+func GetGlobalDiagnostics(program *compiler.Program, configFile *ast.SourceFile) []rule.RuleDiagnostic {
+	globalDiagnostics := compiler.SortAndDeduplicateDiagnostics(append(
+		program.GetProgramDiagnostics(),
+		program.GetOptionsDiagnostics(context.Background())...,
+	))
+	var diagnostics []rule.RuleDiagnostic
+	for _, diagnostic := range globalDiagnostics {
+		if diagnostic.File() == nil && configFile == nil {
+			continue
+		}
+		diagnostics = append(diagnostics, convertTSDiagnostic(diagnostic, configFile))
+	}
+	return diagnostics
+}
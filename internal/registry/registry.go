@@ -0,0 +1,60 @@
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+)
+
+var rules = map[string]rule.Rule{}
+
+// Register adds the rule to the registry and returns it unchanged, so rules can
+// register themselves in their package-level declaration:
+//
+//	var MyRule = registry.Register(rule.Rule{...})
+func Register(r rule.Rule) rule.Rule {
+	if r.Name == "" || r.Run == nil {
+		panic("registry: rule must have a name and a Run function")
+	}
+	if _, ok := rules[r.Name]; ok {
+		panic(fmt.Sprintf("registry: rule %q is already registered", r.Name))
+	}
+	rules[r.Name] = r
+	return r
+}
+
+// All returns every registered rule sorted by name.
+func All() []rule.Rule {
+	return slices.SortedFunc(maps.Values(rules), func(a, b rule.Rule) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+}
+
+func Get(name string) (rule.Rule, bool) {
+	r, ok := rules[name]
+	return r, ok
+}
+
+func AcceptsOptions(r rule.Rule) bool {
+	return r.Meta.DefaultOptions != nil
+}
+
+// DecodeOptions strictly decodes JSON rule options into the rule's options
+// struct, whose type is taken from the rule's default options. The struct is
+// returned by value, since that is what rules type-assert on.
+func DecodeOptions(r rule.Rule, data json.RawMessage) (any, error) {
+	if !AcceptsOptions(r) {
+		return nil, fmt.Errorf("rule %q doesn't accept options", r.Name)
+	}
+	options := reflect.New(reflect.TypeOf(r.Meta.DefaultOptions))
+	if err := utils.DecodeJSONStrict(data, options.Interface()); err != nil {
+		return nil, err
+	}
+	return options.Elem().Interface(), nil
+}
@@ -0,0 +1,72 @@
+package registry_test
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/typescript-eslint/tsgolint/internal/registry"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules"
+	"gotest.tools/v3/assert"
+)
+
+var ruleNameRegexp = regexp.MustCompile(`^[a-z]+(-[a-z]+)*$`)
+
+func TestRegisteredRulesMetadata(t *testing.T) {
+	rules := registry.All()
+	assert.Assert(t, len(rules) > 0, "no rules are registered")
+
+	for i, r := range rules {
+		t.Run(r.Name, func(t *testing.T) {
+			if i > 0 {
+				assert.Assert(t, rules[i-1].Name < r.Name, "rules should be sorted by name")
+			}
+			assert.Assert(t, ruleNameRegexp.MatchString(r.Name), "invalid rule name")
+			assert.Assert(t, r.Meta.Description != "", "missing description")
+			assert.Equal(t, r.Meta.DocsURL, "https://typescript-eslint.io/rules/"+r.Name)
+			assert.Assert(t, r.Meta.Category == rule.RuleCategoryProblem || r.Meta.Category == rule.RuleCategorySuggestion, "invalid category %q", r.Meta.Category)
+
+			got, ok := registry.Get(r.Name)
+			assert.Assert(t, ok)
+			assert.Equal(t, got.Name, r.Name)
+
+			if !registry.AcceptsOptions(r) {
+				assert.Assert(t, registry.OptionsSchema(r) == nil)
+				_, err := registry.DecodeOptions(r, []byte(`{}`))
+				assert.ErrorContains(t, err, "doesn't accept options")
+				return
+			}
+
+			schema := registry.OptionsSchema(r)
+			assert.Assert(t, schema != nil)
+
+			options, err := registry.DecodeOptions(r, []byte(`{}`))
+			assert.NilError(t, err)
+			assert.Equal(t, reflect.TypeOf(options), reflect.TypeOf(r.Meta.DefaultOptions))
+		})
+	}
+}
+
+func TestOptionsSchema(t *testing.T) {
+	r, ok := registry.Get("no-floating-promises")
+	assert.Assert(t, ok)
+
+	schema := registry.OptionsSchema(r)
+	assert.Equal(t, schema["type"], "object")
+	assert.Equal(t, schema["additionalProperties"], false)
+
+	properties := schema["properties"].(map[string]any)
+	assert.DeepEqual(t, properties["ignoreVoid"], map[string]any{"type": "boolean", "default": true})
+	assert.Equal(t, properties["allowForKnownSafeCalls"].(map[string]any)["type"], "array")
+}
+
+func TestRegisterDuplicate(t *testing.T) {
+	r, ok := registry.Get("no-floating-promises")
+	assert.Assert(t, ok)
+
+	defer func() {
+		assert.Assert(t, recover() != nil, "registering a rule twice should panic")
+	}()
+	registry.Register(r)
+}
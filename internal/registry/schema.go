@@ -0,0 +1,95 @@
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+)
+
+// Options types whose JSON form doesn't follow their Go shape (e.g. because of
+// a custom UnmarshalJSON) describe themselves by implementing this interface.
+type jsonSchemaProvider interface {
+	JSONSchema() map[string]any
+}
+
+var jsonSchemaProviderType = reflect.TypeFor[jsonSchemaProvider]()
+
+// OptionsSchema returns a JSON schema of the rule's options, with defaults
+// taken from the rule's default options. nil if the rule doesn't accept options.
+func OptionsSchema(r rule.Rule) map[string]any {
+	if !AcceptsOptions(r) {
+		return nil
+	}
+	defaults := reflect.ValueOf(r.Meta.DefaultOptions)
+	return schemaForType(defaults.Type(), defaults)
+}
+
+func schemaForType(t reflect.Type, defaults reflect.Value) map[string]any {
+	if t.Implements(jsonSchemaProviderType) {
+		return reflect.Zero(t).Interface().(jsonSchemaProvider).JSONSchema()
+	}
+	if reflect.PointerTo(t).Implements(jsonSchemaProviderType) {
+		return reflect.New(t).Interface().(jsonSchemaProvider).JSONSchema()
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		if defaults.IsValid() && !defaults.IsNil() {
+			defaults = defaults.Elem()
+		} else {
+			defaults = reflect.Value{}
+		}
+		return schemaForType(t.Elem(), defaults)
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice:
+		return map[string]any{"type": "array", "items": schemaForType(t.Elem(), reflect.Value{})}
+	case reflect.Struct:
+		properties := make(map[string]any, t.NumField())
+		for i := range t.NumField() {
+			field := t.Field(i)
+			var fieldDefault reflect.Value
+			if defaults.IsValid() {
+				fieldDefault = defaults.Field(i)
+			}
+			property := schemaForType(field.Type, fieldDefault)
+			if defaultValue, ok := jsonValue(fieldDefault); ok {
+				property["default"] = defaultValue
+			}
+			properties[lowerFirst(field.Name)] = property
+		}
+		return map[string]any{
+			"type":                 "object",
+			"properties":           properties,
+			"additionalProperties": false,
+		}
+	default:
+		panic(fmt.Sprintf("registry: can't describe options of kind %v", t.Kind()))
+	}
+}
+
+func jsonValue(v reflect.Value) (any, bool) {
+	if !v.IsValid() || ((v.Kind() == reflect.Pointer || v.Kind() == reflect.Slice) && v.IsNil()) {
+		return nil, false
+	}
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return nil, false
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+func lowerFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToLower(r)) + s[size:]
+}
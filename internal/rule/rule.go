@@ -31,8 +31,28 @@ func ListenerOnNotAllowPattern(kind ast.Kind) ast.Kind {
 
 type RuleListeners map[ast.Kind](func(node *ast.Node))
 
+type RuleCategory string
+
+const (
+	// Code that will cause an error or may cause confusing behavior
+	RuleCategoryProblem RuleCategory = "problem"
+	// Code that works, but could be done in a better way
+	RuleCategorySuggestion RuleCategory = "suggestion"
+)
+
+type RuleMeta struct {
+	Description    string
+	DocsURL        string
+	Category       RuleCategory
+	Fixable        bool
+	HasSuggestions bool
+	// Options struct with the defaults filled in. nil if the rule doesn't accept options
+	DefaultOptions any
+}
+
 type Rule struct {
 	Name string
+	Meta RuleMeta
 	Run  func(ctx RuleContext, options any) RuleListeners
 }
 
@@ -84,9 +104,28 @@ func (s RuleSuggestion) Fixes() []RuleFix {
 	return s.FixesArr
 }
 
+type DiagnosticSeverity uint8
+
+const (
+	SeverityError DiagnosticSeverity = iota
+	SeverityWarning
+)
+
+func (s DiagnosticSeverity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	default:
+		panic("unexpected DiagnosticSeverity")
+	}
+}
+
 type RuleDiagnostic struct {
 	Range    core.TextRange
 	RuleName string
+	Severity DiagnosticSeverity
 	Message  RuleMessage
 	// nil if no fixes were provided
 	FixesPtr *[]RuleFix
@@ -1,6 +1,7 @@
 package rule_tester
 
 import (
+	"context"
 	"slices"
 	"strconv"
 	"sync"
@@ -73,6 +74,7 @@ func RunRuleTester(rootDir string, tsconfigPath string, t *testing.T, r *rule.Ru
 
 		program, err := utils.CreateProgram(true, fs, rootDir, tsconfigPath, host)
 		assert.NilError(t, err, "couldn't create program. code: "+code)
+		assert.Assert(t, len(program.GetSyntacticDiagnostics(context.Background(), nil)) == 0, "code has syntax errors. code: "+code)
 
 		files := []*ast.SourceFile{program.GetSourceFile(fileName)}
 
@@ -90,6 +92,7 @@ func RunRuleTester(rootDir string, tsconfigPath string, t *testing.T, r *rule.Ru
 					},
 				}
 			},
+			linter.Options{},
 			func(diagnostic rule.RuleDiagnostic) {
 				diagnosticsMu.Lock()
 				defer diagnosticsMu.Unlock()
@@ -100,6 +103,15 @@ func RunRuleTester(rootDir string, tsconfigPath string, t *testing.T, r *rule.Ru
 
 		assert.NilError(t, err, "error running linter. code:\n", code)
 
+		for _, d := range diagnostics {
+			if d.FixesPtr != nil && !r.Meta.Fixable {
+				t.Errorf("Rule reported a fix, but it isn't marked as fixable in its metadata")
+			}
+			if d.Suggestions != nil && !r.Meta.HasSuggestions {
+				t.Errorf("Rule reported suggestions, but it isn't marked as having suggestions in its metadata")
+			}
+		}
+
 		return diagnostics
 	}
 
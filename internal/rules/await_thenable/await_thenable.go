@@ -3,6 +3,7 @@ package await_thenable
 import (
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -42,8 +43,14 @@ func buildAwaitUsingOfNonAsyncDisposableMessage() rule.RuleMessage {
 	}
 }
 
-var AwaitThenableRule = rule.Rule{
+var AwaitThenableRule = registry.Register(rule.Rule{
 	Name: "await-thenable",
+	Meta: rule.RuleMeta{
+		Description:    "Disallow awaiting a value that is not a Thenable",
+		DocsURL:        "https://typescript-eslint.io/rules/await-thenable",
+		Category:       rule.RuleCategoryProblem,
+		HasSuggestions: true,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindAwaitExpression: func(node *ast.Node) {
@@ -131,4 +138,4 @@ var AwaitThenableRule = rule.Rule{
 			},
 		}
 	},
-}
+})
@@ -4,6 +4,7 @@ import (
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
 	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -21,8 +22,14 @@ func buildUseSpliceMessage() rule.RuleMessage {
 	}
 }
 
-var NoArrayDeleteRule = rule.Rule{
+var NoArrayDeleteRule = registry.Register(rule.Rule{
 	Name: "no-array-delete",
+	Meta: rule.RuleMeta{
+		Description:    "Disallow using the `delete` operator on array values",
+		DocsURL:        "https://typescript-eslint.io/rules/no-array-delete",
+		Category:       rule.RuleCategoryProblem,
+		HasSuggestions: true,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		isUnderlyingTypeArray := func(t *checker.Type) bool {
 			if utils.IsTypeFlagSet(t, checker.TypeFlagsUnion) {
@@ -83,4 +90,4 @@ var NoArrayDeleteRule = rule.Rule{
 			},
 		}
 	},
-}
+})
@@ -6,6 +6,7 @@ import (
 
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -40,6 +41,13 @@ type NoBaseToStringOptions struct {
 	IgnoredTypeNames []string
 }
 
+func (opts NoBaseToStringOptions) withDefaults() NoBaseToStringOptions {
+	if opts.IgnoredTypeNames == nil {
+		opts.IgnoredTypeNames = []string{"Error", "RegExp", "URL", "URLSearchParams"}
+	}
+	return opts
+}
+
 type usefulness uint32
 
 const (
@@ -48,15 +56,20 @@ const (
 	usefulnessSometimes
 )
 
-var NoBaseToStringRule = rule.Rule{
+var NoBaseToStringRule = registry.Register(rule.Rule{
 	Name: "no-base-to-string",
+	Meta: rule.RuleMeta{
+		Description:    "Require `.toString()` and `.toLocaleString()` to only be called on objects which provide useful information when stringified",
+		DocsURL:        "https://typescript-eslint.io/rules/no-base-to-string",
+		Category:       rule.RuleCategorySuggestion,
+		DefaultOptions: NoBaseToStringOptions{}.withDefaults(),
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		opts, ok := options.(NoBaseToStringOptions)
 		if !ok {
-			opts = NoBaseToStringOptions{
-				IgnoredTypeNames: []string{"Error", "RegExp", "URL", "URLSearchParams"},
-			}
+			opts = NoBaseToStringOptions{}
 		}
+		opts = opts.withDefaults()
 
 		var collectToStringCertainty func(
 			t *checker.Type,
@@ -327,4 +340,4 @@ var NoBaseToStringRule = rule.Rule{
 			},
 		}
 	},
-}
+})
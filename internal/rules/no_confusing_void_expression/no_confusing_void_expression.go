@@ -4,6 +4,7 @@ import (
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
 	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -63,8 +64,16 @@ type NoConfusingVoidExpressionOptions struct {
 	IgnoreVoidReturningFunctions bool
 }
 
-var NoConfusingVoidExpressionRule = rule.Rule{
+var NoConfusingVoidExpressionRule = registry.Register(rule.Rule{
 	Name: "no-confusing-void-expression",
+	Meta: rule.RuleMeta{
+		Description:    "Require expressions of type void to appear in statement position",
+		DocsURL:        "https://typescript-eslint.io/rules/no-confusing-void-expression",
+		Category:       rule.RuleCategorySuggestion,
+		Fixable:        true,
+		HasSuggestions: true,
+		DefaultOptions: NoConfusingVoidExpressionOptions{},
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		opts, ok := options.(NoConfusingVoidExpressionOptions)
 		if !ok {
@@ -293,4 +302,4 @@ var NoConfusingVoidExpressionRule = rule.Rule{
 			ast.KindTaggedTemplateExpression: checkExpression,
 		}
 	},
-}
+})
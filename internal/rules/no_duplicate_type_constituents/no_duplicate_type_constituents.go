@@ -7,6 +7,7 @@ import (
 	"github.com/microsoft/typescript-go/shim/checker"
 	"github.com/microsoft/typescript-go/shim/core"
 	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -42,15 +43,19 @@ type NoDuplicateTypeConstituentsOptions struct {
 	IgnoreUnions        bool
 }
 
-var NoDuplicateTypeConstituentsRule = rule.Rule{
+var NoDuplicateTypeConstituentsRule = registry.Register(rule.Rule{
 	Name: "no-duplicate-type-constituents",
+	Meta: rule.RuleMeta{
+		Description:    "Disallow duplicate constituents of union or intersection types",
+		DocsURL:        "https://typescript-eslint.io/rules/no-duplicate-type-constituents",
+		Category:       rule.RuleCategorySuggestion,
+		Fixable:        true,
+		DefaultOptions: NoDuplicateTypeConstituentsOptions{},
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		opts, ok := options.(NoDuplicateTypeConstituentsOptions)
 		if !ok {
-			opts = NoDuplicateTypeConstituentsOptions{
-				IgnoreIntersections: false,
-				IgnoreUnions:        false,
-			}
+			opts = NoDuplicateTypeConstituentsOptions{}
 		}
 
 		unwindedParentType := func(node *ast.Node, kind ast.Kind) *ast.Node {
@@ -294,4 +299,4 @@ var NoDuplicateTypeConstituentsRule = rule.Rule{
 
 		return ruleListeners
 	},
-}
+})
@@ -4,6 +4,7 @@ import (
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
 	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -18,6 +19,31 @@ type NoFloatingPromisesOptions struct {
 	IgnoreVoid                      *bool
 }
 
+func (opts NoFloatingPromisesOptions) withDefaults() NoFloatingPromisesOptions {
+	if opts.AllowForKnownSafeCalls == nil {
+		opts.AllowForKnownSafeCalls = []utils.TypeOrValueSpecifier{}
+	}
+	if opts.AllowForKnownSafeCallsInline == nil {
+		opts.AllowForKnownSafeCallsInline = []string{}
+	}
+	if opts.AllowForKnownSafePromises == nil {
+		opts.AllowForKnownSafePromises = []utils.TypeOrValueSpecifier{}
+	}
+	if opts.AllowForKnownSafePromisesInline == nil {
+		opts.AllowForKnownSafePromisesInline = []string{}
+	}
+	if opts.CheckThenables == nil {
+		opts.CheckThenables = utils.Ref(false)
+	}
+	if opts.IgnoreIIFE == nil {
+		opts.IgnoreIIFE = utils.Ref(false)
+	}
+	if opts.IgnoreVoid == nil {
+		opts.IgnoreVoid = utils.Ref(true)
+	}
+	return opts
+}
+
 var messageBase = "Promises must be awaited, end with a call to .catch, or end with a call to .then with a rejection handler."
 
 var messageBaseVoid = "Promises must be awaited, end with a call to .catch, end with a call to .then with a rejection handler" +
@@ -75,27 +101,21 @@ func buildFloatingVoidMessage() rule.RuleMessage {
 	}
 }
 
-var NoFloatingPromisesRule = rule.Rule{
+var NoFloatingPromisesRule = registry.Register(rule.Rule{
 	Name: "no-floating-promises",
+	Meta: rule.RuleMeta{
+		Description:    "Require Promise-like statements to be handled appropriately",
+		DocsURL:        "https://typescript-eslint.io/rules/no-floating-promises",
+		Category:       rule.RuleCategoryProblem,
+		HasSuggestions: true,
+		DefaultOptions: NoFloatingPromisesOptions{}.withDefaults(),
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		opts, ok := options.(NoFloatingPromisesOptions)
 		if !ok {
-			opts = NoFloatingPromisesOptions{
-				AllowForKnownSafeCalls:          []utils.TypeOrValueSpecifier{},
-				AllowForKnownSafeCallsInline:    []string{},
-				AllowForKnownSafePromises:       []utils.TypeOrValueSpecifier{},
-				AllowForKnownSafePromisesInline: []string{},
-			}
-		}
-		if opts.CheckThenables == nil {
-			opts.CheckThenables = utils.Ref(false)
-		}
-		if opts.IgnoreIIFE == nil {
-			opts.IgnoreIIFE = utils.Ref(false)
-		}
-		if opts.IgnoreVoid == nil {
-			opts.IgnoreVoid = utils.Ref(true)
+			opts = NoFloatingPromisesOptions{}
 		}
+		opts = opts.withDefaults()
 
 		isHigherPrecedenceThanUnary := func(node *ast.Node) bool {
 			operator := ast.KindUnknown
@@ -431,4 +451,4 @@ var NoFloatingPromisesRule = rule.Rule{
 			},
 		}
 	},
-}
+})
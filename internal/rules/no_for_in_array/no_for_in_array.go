@@ -3,6 +3,7 @@ package no_for_in_array
 import (
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -14,8 +15,13 @@ func buildForInViolationMessage() rule.RuleMessage {
 	}
 }
 
-var NoForInArrayRule = rule.Rule{
-	Name: "no-for-in-array-rule",
+var NoForInArrayRule = registry.Register(rule.Rule{
+	Name: "no-for-in-array",
+	Meta: rule.RuleMeta{
+		Description: "Disallow iterating over an array with a for-in loop",
+		DocsURL:     "https://typescript-eslint.io/rules/no-for-in-array",
+		Category:    rule.RuleCategoryProblem,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		hasArrayishLength := func(t *checker.Type) bool {
 			lengthProperty := checker.Checker_getPropertyOfType(ctx.TypeChecker, t, "length")
@@ -44,4 +50,4 @@ var NoForInArrayRule = rule.Rule{
 			},
 		}
 	},
-}
+})
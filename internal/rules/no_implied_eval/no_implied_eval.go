@@ -5,6 +5,7 @@ import (
 
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -25,8 +26,13 @@ func buildNoImpliedEvalErrorMessage() rule.RuleMessage {
 var globalCandidates = []string{"global", "globalThis", "window"}
 var evalLikeFunctions = []string{"execScript", "setImmediate", "setInterval", "setTimeout"}
 
-var NoImpliedEvalRule = rule.Rule{
+var NoImpliedEvalRule = registry.Register(rule.Rule{
 	Name: "no-implied-eval",
+	Meta: rule.RuleMeta{
+		Description: "Disallow the use of `eval()`-like functions",
+		DocsURL:     "https://typescript-eslint.io/rules/no-implied-eval",
+		Category:    rule.RuleCategorySuggestion,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		getCalleeName := func(node *ast.Expression) string {
 			if ast.IsIdentifier(node) {
@@ -127,4 +133,4 @@ var NoImpliedEvalRule = rule.Rule{
 			ast.KindNewExpression:  checkImpliedEval,
 		}
 	},
-}
+})
@@ -5,6 +5,7 @@ import (
 
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -26,16 +27,29 @@ type NoMeaninglessVoidOperatorOptions struct {
 	CheckNever *bool
 }
 
-var NoMeaninglessVoidOperatorRule = rule.Rule{
+func (opts NoMeaninglessVoidOperatorOptions) withDefaults() NoMeaninglessVoidOperatorOptions {
+	if opts.CheckNever == nil {
+		opts.CheckNever = utils.Ref(false)
+	}
+	return opts
+}
+
+var NoMeaninglessVoidOperatorRule = registry.Register(rule.Rule{
 	Name: "no-meaningless-void-operator",
+	Meta: rule.RuleMeta{
+		Description:    "Disallow the `void` operator except when used to discard a value",
+		DocsURL:        "https://typescript-eslint.io/rules/no-meaningless-void-operator",
+		Category:       rule.RuleCategorySuggestion,
+		Fixable:        true,
+		HasSuggestions: true,
+		DefaultOptions: NoMeaninglessVoidOperatorOptions{}.withDefaults(),
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		opts, ok := options.(NoMeaninglessVoidOperatorOptions)
 		if !ok {
 			opts = NoMeaninglessVoidOperatorOptions{}
 		}
-		if opts.CheckNever == nil {
-			opts.CheckNever = utils.Ref(false)
-		}
+		opts = opts.withDefaults()
 
 		return rule.RuleListeners{
 			ast.KindVoidExpression: func(node *ast.Node) {
@@ -63,4 +77,4 @@ var NoMeaninglessVoidOperatorRule = rule.Rule{
 			},
 		}
 	},
-}
+})
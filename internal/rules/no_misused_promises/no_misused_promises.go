@@ -1,11 +1,14 @@
 package no_misused_promises
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"slices"
 
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -80,43 +83,115 @@ type NoMisusedPromisesOptions struct {
 	ChecksVoidReturnOpts *NoMisusedPromisesChecksVoidReturnOptions
 }
 
-var NoMisusedPromisesRule = rule.Rule{
+// UnmarshalJSON accepts "checksVoidReturn" either as a boolean or as an object
+// with per-position checks, like typescript-eslint does.
+func (o *NoMisusedPromisesOptions) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		ChecksConditionals *bool
+		ChecksSpreads      *bool
+		ChecksVoidReturn   json.RawMessage
+	}
+	if err := utils.DecodeJSONStrict(data, &raw); err != nil {
+		return err
+	}
+	o.ChecksConditionals = raw.ChecksConditionals
+	o.ChecksSpreads = raw.ChecksSpreads
+
+	checksVoidReturn := bytes.TrimSpace(raw.ChecksVoidReturn)
+	if len(checksVoidReturn) == 0 || bytes.Equal(checksVoidReturn, []byte("null")) {
+		return nil
+	}
+	if checksVoidReturn[0] == '{' {
+		o.ChecksVoidReturnOpts = &NoMisusedPromisesChecksVoidReturnOptions{}
+		return utils.DecodeJSONStrict(checksVoidReturn, o.ChecksVoidReturnOpts)
+	}
+	o.ChecksVoidReturn = new(bool)
+	if err := json.Unmarshal(checksVoidReturn, o.ChecksVoidReturn); err != nil {
+		return fmt.Errorf(`"checksVoidReturn" must be a boolean or an object`)
+	}
+	return nil
+}
+
+func (o NoMisusedPromisesOptions) JSONSchema() map[string]any {
+	boolean := map[string]any{"type": "boolean", "default": true}
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"checksConditionals": boolean,
+			"checksSpreads":      boolean,
+			"checksVoidReturn": map[string]any{
+				"oneOf": []any{
+					boolean,
+					map[string]any{
+						"type": "object",
+						"properties": map[string]any{
+							"arguments":        boolean,
+							"attributes":       boolean,
+							"inheritedMethods": boolean,
+							"properties":       boolean,
+							"returns":          boolean,
+							"variables":        boolean,
+						},
+						"additionalProperties": false,
+					},
+				},
+			},
+		},
+		"additionalProperties": false,
+	}
+}
+
+func (opts NoMisusedPromisesOptions) withDefaults() NoMisusedPromisesOptions {
+	if opts.ChecksConditionals == nil {
+		opts.ChecksConditionals = utils.Ref(true)
+	}
+	if opts.ChecksSpreads == nil {
+		opts.ChecksSpreads = utils.Ref(true)
+	}
+	if opts.ChecksVoidReturn == nil {
+		opts.ChecksVoidReturn = utils.Ref(true)
+	}
+	// options are shared between files, so the nested struct is copied before filling it in
+	checksVoidReturnOpts := NoMisusedPromisesChecksVoidReturnOptions{}
+	if opts.ChecksVoidReturnOpts != nil {
+		checksVoidReturnOpts = *opts.ChecksVoidReturnOpts
+	}
+	opts.ChecksVoidReturnOpts = &checksVoidReturnOpts
+	if opts.ChecksVoidReturnOpts.Arguments == nil {
+		opts.ChecksVoidReturnOpts.Arguments = utils.Ref(true)
+	}
+	if opts.ChecksVoidReturnOpts.Attributes == nil {
+		opts.ChecksVoidReturnOpts.Attributes = utils.Ref(true)
+	}
+	if opts.ChecksVoidReturnOpts.InheritedMethods == nil {
+		opts.ChecksVoidReturnOpts.InheritedMethods = utils.Ref(true)
+	}
+	if opts.ChecksVoidReturnOpts.Properties == nil {
+		opts.ChecksVoidReturnOpts.Properties = utils.Ref(true)
+	}
+	if opts.ChecksVoidReturnOpts.Returns == nil {
+		opts.ChecksVoidReturnOpts.Returns = utils.Ref(true)
+	}
+	if opts.ChecksVoidReturnOpts.Variables == nil {
+		opts.ChecksVoidReturnOpts.Variables = utils.Ref(true)
+	}
+	return opts
+}
+
+var NoMisusedPromisesRule = registry.Register(rule.Rule{
 	Name: "no-misused-promises",
+	Meta: rule.RuleMeta{
+		Description:    "Disallow Promises in places not designed to handle them",
+		DocsURL:        "https://typescript-eslint.io/rules/no-misused-promises",
+		Category:       rule.RuleCategoryProblem,
+		DefaultOptions: NoMisusedPromisesOptions{}.withDefaults(),
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		opts, ok := options.(NoMisusedPromisesOptions)
 		if !ok {
 			opts = NoMisusedPromisesOptions{}
 		}
-		if opts.ChecksConditionals == nil {
-			opts.ChecksConditionals = utils.Ref(true)
-		}
-		if opts.ChecksSpreads == nil {
-			opts.ChecksSpreads = utils.Ref(true)
-		}
-		if opts.ChecksVoidReturn == nil {
-			opts.ChecksVoidReturn = utils.Ref(true)
-		}
-		if opts.ChecksVoidReturnOpts == nil {
-			opts.ChecksVoidReturnOpts = utils.Ref(NoMisusedPromisesChecksVoidReturnOptions{})
-		}
-		if opts.ChecksVoidReturnOpts.Arguments == nil {
-			opts.ChecksVoidReturnOpts.Arguments = utils.Ref(true)
-		}
-		if opts.ChecksVoidReturnOpts.Attributes == nil {
-			opts.ChecksVoidReturnOpts.Attributes = utils.Ref(true)
-		}
-		if opts.ChecksVoidReturnOpts.InheritedMethods == nil {
-			opts.ChecksVoidReturnOpts.InheritedMethods = utils.Ref(true)
-		}
-		if opts.ChecksVoidReturnOpts.Properties == nil {
-			opts.ChecksVoidReturnOpts.Properties = utils.Ref(true)
-		}
-		if opts.ChecksVoidReturnOpts.Returns == nil {
-			opts.ChecksVoidReturnOpts.Returns = utils.Ref(true)
-		}
-		if opts.ChecksVoidReturnOpts.Variables == nil {
-			opts.ChecksVoidReturnOpts.Variables = utils.Ref(true)
-		}
+		opts = opts.withDefaults()
 
 		anySignatureIsThenableType := func(
 			node *ast.Node,
@@ -797,4 +872,4 @@ var NoMisusedPromisesRule = rule.Rule{
 		return listeners
 
 	},
-}
+})
@@ -5,6 +5,7 @@ import (
 	"github.com/microsoft/typescript-go/shim/checker"
 	"github.com/microsoft/typescript-go/shim/compiler"
 	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -79,6 +80,16 @@ type NoMisusedSpreadOptions struct {
 	AllowInline []string
 }
 
+func (opts NoMisusedSpreadOptions) withDefaults() NoMisusedSpreadOptions {
+	if opts.Allow == nil {
+		opts.Allow = []utils.TypeOrValueSpecifier{}
+	}
+	if opts.AllowInline == nil {
+		opts.AllowInline = []string{}
+	}
+	return opts
+}
+
 func isString(t *checker.Type) bool {
 	return utils.TypeRecurser(t, func(t *checker.Type) bool {
 		return utils.IsTypeFlagSet(t, checker.TypeFlagsStringLike)
@@ -150,19 +161,21 @@ func isClassDeclaration(t *checker.Type) bool {
 	})
 }
 
-var NoMisusedSpreadRule = rule.Rule{
+var NoMisusedSpreadRule = registry.Register(rule.Rule{
 	Name: "no-misused-spread",
+	Meta: rule.RuleMeta{
+		Description:    "Disallow using the spread operator when it might cause unexpected behavior",
+		DocsURL:        "https://typescript-eslint.io/rules/no-misused-spread",
+		Category:       rule.RuleCategoryProblem,
+		HasSuggestions: true,
+		DefaultOptions: NoMisusedSpreadOptions{}.withDefaults(),
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		opts, ok := options.(NoMisusedSpreadOptions)
 		if !ok {
 			opts = NoMisusedSpreadOptions{}
 		}
-		if opts.Allow == nil {
-			opts.Allow = []utils.TypeOrValueSpecifier{}
-		}
-		if opts.AllowInline == nil {
-			opts.AllowInline = []string{}
-		}
+		opts = opts.withDefaults()
 
 		checkArrayOrCallSpread := func(node *ast.Node) {
 			t := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, node.AsSpreadElement().Expression)
@@ -300,4 +313,4 @@ var NoMisusedSpreadRule = rule.Rule{
 			},
 		}
 	},
-}
+})
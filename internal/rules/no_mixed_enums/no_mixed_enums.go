@@ -3,6 +3,7 @@ package no_mixed_enums
 import (
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -22,8 +23,13 @@ const (
 	allowedTypeUnknown
 )
 
-var NoMixedEnumsRule = rule.Rule{
+var NoMixedEnumsRule = registry.Register(rule.Rule{
 	Name: "no-mixed-enums",
+	Meta: rule.RuleMeta{
+		Description: "Disallow enums from having both number and string members",
+		DocsURL:     "https://typescript-eslint.io/rules/no-mixed-enums",
+		Category:    rule.RuleCategoryProblem,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		getMemberType := func(node *ast.Node) allowedType {
 			initializer := node.AsEnumMember().Initializer
@@ -86,4 +92,4 @@ var NoMixedEnumsRule = rule.Rule{
 			},
 		}
 	},
-}
+})
@@ -6,6 +6,7 @@ import (
 
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -91,8 +92,13 @@ func (t *typeFlagsWithNodeOrType) ToString(typeChecker *checker.Checker) string
 	return typeChecker.TypeToString(t.t)
 }
 
-var NoRedundantTypeConstituentsRule = rule.Rule{
+var NoRedundantTypeConstituentsRule = registry.Register(rule.Rule{
 	Name: "no-redundant-type-constituents",
+	Meta: rule.RuleMeta{
+		Description: "Disallow members of unions and intersections that do nothing or override type information",
+		DocsURL:     "https://typescript-eslint.io/rules/no-redundant-type-constituents",
+		Category:    rule.RuleCategorySuggestion,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		var getTypeNodeTypePartFlags func(node *ast.Node) []typeFlagsWithNodeOrType
 		getTypeNodeTypePartFlags = func(node *ast.Node) []typeFlagsWithNodeOrType {
@@ -401,4 +407,4 @@ var NoRedundantTypeConstituentsRule = rule.Rule{
 			},
 		}
 	},
-}
+})
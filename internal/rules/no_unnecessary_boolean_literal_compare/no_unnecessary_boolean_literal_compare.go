@@ -4,6 +4,7 @@ import (
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
 	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -51,6 +52,19 @@ type NoUnnecessaryBooleanLiteralCompareOptions struct {
 	AllowRuleToRunWithoutStrictNullChecksIKnowWhatIAmDoing *bool
 }
 
+func (opts NoUnnecessaryBooleanLiteralCompareOptions) withDefaults() NoUnnecessaryBooleanLiteralCompareOptions {
+	if opts.AllowComparingNullableBooleansToFalse == nil {
+		opts.AllowComparingNullableBooleansToFalse = utils.Ref(true)
+	}
+	if opts.AllowComparingNullableBooleansToTrue == nil {
+		opts.AllowComparingNullableBooleansToTrue = utils.Ref(true)
+	}
+	if opts.AllowRuleToRunWithoutStrictNullChecksIKnowWhatIAmDoing == nil {
+		opts.AllowRuleToRunWithoutStrictNullChecksIKnowWhatIAmDoing = utils.Ref(false)
+	}
+	return opts
+}
+
 type booleanComparison struct {
 	expression                  *ast.Expression
 	literalBooleanInComparison  bool
@@ -62,22 +76,21 @@ func isBooleanType(t *checker.Type) bool {
 	return utils.IsTypeFlagSet(t, checker.TypeFlagsBooleanLike)
 }
 
-var NoUnnecessaryBooleanLiteralCompareRule = rule.Rule{
+var NoUnnecessaryBooleanLiteralCompareRule = registry.Register(rule.Rule{
 	Name: "no-unnecessary-boolean-literal-compare",
+	Meta: rule.RuleMeta{
+		Description:    "Disallow unnecessary equality comparisons against boolean literals",
+		DocsURL:        "https://typescript-eslint.io/rules/no-unnecessary-boolean-literal-compare",
+		Category:       rule.RuleCategorySuggestion,
+		Fixable:        true,
+		DefaultOptions: NoUnnecessaryBooleanLiteralCompareOptions{}.withDefaults(),
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		opts, ok := options.(NoUnnecessaryBooleanLiteralCompareOptions)
 		if !ok {
 			opts = NoUnnecessaryBooleanLiteralCompareOptions{}
 		}
-		if opts.AllowComparingNullableBooleansToFalse == nil {
-			opts.AllowComparingNullableBooleansToFalse = utils.Ref(true)
-		}
-		if opts.AllowComparingNullableBooleansToTrue == nil {
-			opts.AllowComparingNullableBooleansToTrue = utils.Ref(true)
-		}
-		if opts.AllowRuleToRunWithoutStrictNullChecksIKnowWhatIAmDoing == nil {
-			opts.AllowRuleToRunWithoutStrictNullChecksIKnowWhatIAmDoing = utils.Ref(false)
-		}
+		opts = opts.withDefaults()
 
 		compilerOptions := ctx.Program.Options()
 		isStrictNullChecks := utils.IsStrictCompilerOptionEnabled(
@@ -208,4 +221,4 @@ var NoUnnecessaryBooleanLiteralCompareRule = rule.Rule{
 			},
 		}
 	},
-}
+})
@@ -6,6 +6,7 @@ import (
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
 	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -58,8 +59,13 @@ func isWhitespace(str string) bool {
 	return true
 }
 
-var NoUnnecessaryTemplateExpressionRule = rule.Rule{
+var NoUnnecessaryTemplateExpressionRule = registry.Register(rule.Rule{
 	Name: "no-unnecessary-template-expression",
+	Meta: rule.RuleMeta{
+		Description: "Disallow unnecessary template expressions",
+		DocsURL:     "https://typescript-eslint.io/rules/no-unnecessary-template-expression",
+		Category:    rule.RuleCategorySuggestion,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		reportSingleInterpolation := func(spanExpr *ast.Node, spanLiteral *ast.Node) {
 			ctx.ReportRange(core.NewTextRange(spanExpr.Pos()-2, spanLiteral.Pos()+1), buildNoUnnecessaryTemplateExpressionMessage())
@@ -172,4 +178,4 @@ var NoUnnecessaryTemplateExpressionRule = rule.Rule{
 			},
 		}
 	},
-}
+})
@@ -7,6 +7,7 @@ import (
 	"github.com/microsoft/typescript-go/shim/checker"
 	"github.com/microsoft/typescript-go/shim/core"
 	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -26,8 +27,14 @@ func isInTypeContext(node *ast.Node) bool {
 	return ast.IsTypeReferenceNode(node) || ast.IsInterfaceDeclaration(node.Parent) || ast.IsTypeReferenceNode(node.Parent) || (ast.IsHeritageClause(node.Parent) && node.Parent.AsHeritageClause().Token == ast.KindImplementsKeyword)
 }
 
-var NoUnnecessaryTypeArgumentsRule = rule.Rule{
+var NoUnnecessaryTypeArgumentsRule = registry.Register(rule.Rule{
 	Name: "no-unnecessary-type-arguments",
+	Meta: rule.RuleMeta{
+		Description: "Disallow type arguments that are equal to the default",
+		DocsURL:     "https://typescript-eslint.io/rules/no-unnecessary-type-arguments",
+		Category:    rule.RuleCategorySuggestion,
+		Fixable:     true,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		getTypeParametersFromType := func(node *ast.Node, nodeName *ast.Node) []*ast.Node {
 			symbol := ctx.TypeChecker.GetSymbolAtLocation(nodeName)
@@ -170,4 +177,4 @@ var NoUnnecessaryTypeArgumentsRule = rule.Rule{
 			},
 		}
 	},
-}
+})
@@ -7,6 +7,7 @@ import (
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
 	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -29,16 +30,28 @@ type NoUnnecessaryTypeAssertionOptions struct {
 	TypesToIgnore []string
 }
 
-var NoUnnecessaryTypeAssertionRule = rule.Rule{
+func (opts NoUnnecessaryTypeAssertionOptions) withDefaults() NoUnnecessaryTypeAssertionOptions {
+	if opts.TypesToIgnore == nil {
+		opts.TypesToIgnore = []string{}
+	}
+	return opts
+}
+
+var NoUnnecessaryTypeAssertionRule = registry.Register(rule.Rule{
 	Name: "no-unnecessary-type-assertion",
+	Meta: rule.RuleMeta{
+		Description:    "Disallow type assertions that do not change the type of an expression",
+		DocsURL:        "https://typescript-eslint.io/rules/no-unnecessary-type-assertion",
+		Category:       rule.RuleCategorySuggestion,
+		Fixable:        true,
+		DefaultOptions: NoUnnecessaryTypeAssertionOptions{}.withDefaults(),
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		opts, ok := options.(NoUnnecessaryTypeAssertionOptions)
 		if !ok {
 			opts = NoUnnecessaryTypeAssertionOptions{}
 		}
-		if opts.TypesToIgnore == nil {
-			opts.TypesToIgnore = []string{}
-		}
+		opts = opts.withDefaults()
 
 		compilerOptions := ctx.Program.Options()
 		isStrictNullChecks := utils.IsStrictCompilerOptionEnabled(
@@ -311,4 +324,4 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 			},
 		}
 	},
-}
+})
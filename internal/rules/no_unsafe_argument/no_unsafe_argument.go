@@ -5,6 +5,7 @@ import (
 
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -150,8 +151,13 @@ func (s *functionSignature) getNextParameterType() *checker.Type {
 	return s.paramTypes[index]
 }
 
-var NoUnsafeArgumentRule = rule.Rule{
+var NoUnsafeArgumentRule = registry.Register(rule.Rule{
 	Name: "no-unsafe-argument",
+	Meta: rule.RuleMeta{
+		Description: "Disallow calling a function with a value with type `any`",
+		DocsURL:     "https://typescript-eslint.io/rules/no-unsafe-argument",
+		Category:    rule.RuleCategoryProblem,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		describeType := func(t *checker.Type) string {
 			if utils.IsIntrinsicErrorType(t) {
@@ -284,4 +290,4 @@ var NoUnsafeArgumentRule = rule.Rule{
 			},
 		}
 	},
-}
+})
@@ -5,6 +5,7 @@ import (
 
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -65,8 +66,13 @@ const (
 	comparisonTypeContextual
 )
 
-var NoUnsafeAssignmentRule = rule.Rule{
+var NoUnsafeAssignmentRule = registry.Register(rule.Rule{
 	Name: "no-unsafe-assignment",
+	Meta: rule.RuleMeta{
+		Description: "Disallow assigning a value with type `any` to variables and properties",
+		DocsURL:     "https://typescript-eslint.io/rules/no-unsafe-assignment",
+		Category:    rule.RuleCategoryProblem,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		compilerOptions := ctx.Program.Options()
 		isNoImplicitThis := utils.IsStrictCompilerOptionEnabled(
@@ -475,4 +481,4 @@ var NoUnsafeAssignmentRule = rule.Rule{
 			},
 		}
 	},
-}
+})
@@ -5,6 +5,7 @@ import (
 
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -35,8 +36,13 @@ func buildUnsafeTemplateTagMessage(t string) rule.RuleMessage {
 	}
 }
 
-var NoUnsafeCallRule = rule.Rule{
+var NoUnsafeCallRule = registry.Register(rule.Rule{
 	Name: "no-unsafe-call",
+	Meta: rule.RuleMeta{
+		Description: "Disallow calling a value with type `any`",
+		DocsURL:     "https://typescript-eslint.io/rules/no-unsafe-call",
+		Category:    rule.RuleCategoryProblem,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		compilerOptions := ctx.Program.Options()
 		isNoImplicitThis := utils.IsStrictCompilerOptionEnabled(
@@ -125,4 +131,4 @@ var NoUnsafeCallRule = rule.Rule{
 			},
 		}
 	},
-}
+})
@@ -5,6 +5,7 @@ import (
 
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -72,8 +73,13 @@ func typeViolates(leftTypeParts []*checker.Type, rightType *checker.Type) bool {
 	return false
 }
 
-var NoUnsafeEnumComparisonRule = rule.Rule{
+var NoUnsafeEnumComparisonRule = registry.Register(rule.Rule{
 	Name: "no-unsafe-enum-comparison",
+	Meta: rule.RuleMeta{
+		Description: "Disallow comparing an enum value with a non-enum value",
+		DocsURL:     "https://typescript-eslint.io/rules/no-unsafe-enum-comparison",
+		Category:    rule.RuleCategorySuggestion,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		isMismatchedComparison := func(
 			leftType *checker.Type,
@@ -155,4 +161,4 @@ var NoUnsafeEnumComparisonRule = rule.Rule{
 			},
 		}
 	},
-}
+})
@@ -5,6 +5,7 @@ import (
 
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -43,8 +44,13 @@ func createDataType(t *checker.Type) string {
 	return "`any`"
 }
 
-var NoUnsafeMemberAccessRule = rule.Rule{
+var NoUnsafeMemberAccessRule = registry.Register(rule.Rule{
 	Name: "no-unsafe-member-access",
+	Meta: rule.RuleMeta{
+		Description: "Disallow member access on a value with type `any`",
+		DocsURL:     "https://typescript-eslint.io/rules/no-unsafe-member-access",
+		Category:    rule.RuleCategoryProblem,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		compilerOptions := ctx.Program.Options()
 		isNoImplicitThis := utils.IsStrictCompilerOptionEnabled(
@@ -159,4 +165,4 @@ var NoUnsafeMemberAccessRule = rule.Rule{
 			},
 		}
 	},
-}
+})
@@ -5,6 +5,7 @@ import (
 
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -29,8 +30,13 @@ func buildUnsafeReturnThisMessage(t string) rule.RuleMessage {
 	}
 }
 
-var NoUnsafeReturnRule = rule.Rule{
+var NoUnsafeReturnRule = registry.Register(rule.Rule{
 	Name: "no-unsafe-return",
+	Meta: rule.RuleMeta{
+		Description: "Disallow returning a value with type `any` from a function",
+		DocsURL:     "https://typescript-eslint.io/rules/no-unsafe-return",
+		Category:    rule.RuleCategoryProblem,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		compilerOptions := ctx.Program.Options()
 		isNoImplicitThis := utils.IsStrictCompilerOptionEnabled(
@@ -180,4 +186,4 @@ var NoUnsafeReturnRule = rule.Rule{
 			},
 		}
 	},
-}
+})
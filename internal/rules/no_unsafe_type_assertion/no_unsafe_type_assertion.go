@@ -5,6 +5,7 @@ import (
 
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -51,8 +52,13 @@ func isObjectLiteralType(t *checker.Type) bool {
 	return utils.IsObjectType(t) && checker.Type_objectFlags(t)&checker.ObjectFlagsObjectLiteral != 0
 }
 
-var NoUnsafeTypeAssertionRule = rule.Rule{
+var NoUnsafeTypeAssertionRule = registry.Register(rule.Rule{
 	Name: "no-unsafe-type-assertion",
+	Meta: rule.RuleMeta{
+		Description: "Disallow type assertions that narrow a type",
+		DocsURL:     "https://typescript-eslint.io/rules/no-unsafe-type-assertion",
+		Category:    rule.RuleCategoryProblem,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		checkExpression := func(node *ast.Node) {
 			expression := node.Expression()
@@ -118,4 +124,4 @@ var NoUnsafeTypeAssertionRule = rule.Rule{
 			ast.KindTypeAssertionExpression: checkExpression,
 		}
 	},
-}
+})
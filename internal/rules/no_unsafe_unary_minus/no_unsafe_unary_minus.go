@@ -5,6 +5,7 @@ import (
 
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -16,8 +17,13 @@ func buildUnaryMinusMessage(t string) rule.RuleMessage {
 	}
 }
 
-var NoUnsafeUnaryMinusRule = rule.Rule{
+var NoUnsafeUnaryMinusRule = registry.Register(rule.Rule{
 	Name: "no-unsafe-unary-minus",
+	Meta: rule.RuleMeta{
+		Description: "Require unary negation to take a number",
+		DocsURL:     "https://typescript-eslint.io/rules/no-unsafe-unary-minus",
+		Category:    rule.RuleCategoryProblem,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindPrefixUnaryExpression: func(node *ast.Node) {
@@ -38,4 +44,4 @@ var NoUnsafeUnaryMinusRule = rule.Rule{
 			},
 		}
 	},
-}
+})
@@ -4,6 +4,7 @@ import (
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
 	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -15,8 +16,14 @@ func buildPreferNonNullAssertionMessage() rule.RuleMessage {
 	}
 }
 
-var NonNullableTypeAssertionStyleRule = rule.Rule{
+var NonNullableTypeAssertionStyleRule = registry.Register(rule.Rule{
 	Name: "non-nullable-type-assertion-style",
+	Meta: rule.RuleMeta{
+		Description: "Enforce non-null assertions over explicit type assertions",
+		DocsURL:     "https://typescript-eslint.io/rules/non-nullable-type-assertion-style",
+		Category:    rule.RuleCategorySuggestion,
+		Fixable:     true,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		getTypesIfNotLoose := func(node *ast.Node) []*checker.Type {
 			t := ctx.TypeChecker.GetTypeAtLocation(node)
@@ -102,4 +109,4 @@ var NonNullableTypeAssertionStyleRule = rule.Rule{
 			ast.KindTypeAssertionExpression: checkAssertion,
 		}
 	},
-}
+})
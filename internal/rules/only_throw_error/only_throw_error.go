@@ -3,6 +3,7 @@ package only_throw_error
 import (
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -14,6 +15,22 @@ type OnlyThrowErrorOptions struct {
 	AllowThrowingUnknown *bool
 }
 
+func (opts OnlyThrowErrorOptions) withDefaults() OnlyThrowErrorOptions {
+	if opts.Allow == nil {
+		opts.Allow = []utils.TypeOrValueSpecifier{}
+	}
+	if opts.AllowInline == nil {
+		opts.AllowInline = []string{}
+	}
+	if opts.AllowThrowingAny == nil {
+		opts.AllowThrowingAny = utils.Ref(true)
+	}
+	if opts.AllowThrowingUnknown == nil {
+		opts.AllowThrowingUnknown = utils.Ref(true)
+	}
+	return opts
+}
+
 func buildObjectMessage() rule.RuleMessage {
 	return rule.RuleMessage{
 		Id:          "object",
@@ -27,25 +44,20 @@ func buildUndefMessage() rule.RuleMessage {
 	}
 }
 
-var OnlyThrowErrorRule = rule.Rule{
+var OnlyThrowErrorRule = registry.Register(rule.Rule{
 	Name: "only-throw-error",
+	Meta: rule.RuleMeta{
+		Description:    "Disallow throwing non-`Error` values as exceptions",
+		DocsURL:        "https://typescript-eslint.io/rules/only-throw-error",
+		Category:       rule.RuleCategoryProblem,
+		DefaultOptions: OnlyThrowErrorOptions{}.withDefaults(),
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		opts, ok := options.(OnlyThrowErrorOptions)
 		if !ok {
 			opts = OnlyThrowErrorOptions{}
 		}
-		if opts.Allow == nil {
-			opts.Allow = []utils.TypeOrValueSpecifier{}
-		}
-		if opts.AllowInline == nil {
-			opts.AllowInline = []string{}
-		}
-		if opts.AllowThrowingAny == nil {
-			opts.AllowThrowingAny = utils.Ref(true)
-		}
-		if opts.AllowThrowingUnknown == nil {
-			opts.AllowThrowingUnknown = utils.Ref(true)
-		}
+		opts = opts.withDefaults()
 
 		return rule.RuleListeners{
 			ast.KindThrowStatement: func(node *ast.Node) {
@@ -85,4 +97,4 @@ var OnlyThrowErrorRule = rule.Rule{
 			},
 		}
 	},
-}
+})
@@ -3,6 +3,7 @@ package prefer_promise_reject_errors
 import (
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -20,22 +21,33 @@ type PreferPromiseRejectErrorsOptions struct {
 	AllowThrowingUnknown *bool
 }
 
-var PreferPromiseRejectErrorsRule = rule.Rule{
+func (opts PreferPromiseRejectErrorsOptions) withDefaults() PreferPromiseRejectErrorsOptions {
+	if opts.AllowEmptyReject == nil {
+		opts.AllowEmptyReject = utils.Ref(false)
+	}
+	if opts.AllowThrowingAny == nil {
+		opts.AllowThrowingAny = utils.Ref(false)
+	}
+	if opts.AllowThrowingUnknown == nil {
+		opts.AllowThrowingUnknown = utils.Ref(false)
+	}
+	return opts
+}
+
+var PreferPromiseRejectErrorsRule = registry.Register(rule.Rule{
 	Name: "prefer-promise-reject-errors",
+	Meta: rule.RuleMeta{
+		Description:    "Require using Error objects as Promise rejection reasons",
+		DocsURL:        "https://typescript-eslint.io/rules/prefer-promise-reject-errors",
+		Category:       rule.RuleCategorySuggestion,
+		DefaultOptions: PreferPromiseRejectErrorsOptions{}.withDefaults(),
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		opts, ok := options.(PreferPromiseRejectErrorsOptions)
 		if !ok {
 			opts = PreferPromiseRejectErrorsOptions{}
 		}
-		if opts.AllowEmptyReject == nil {
-			opts.AllowEmptyReject = utils.Ref(false)
-		}
-		if opts.AllowThrowingAny == nil {
-			opts.AllowThrowingAny = utils.Ref(false)
-		}
-		if opts.AllowThrowingUnknown == nil {
-			opts.AllowThrowingUnknown = utils.Ref(false)
-		}
+		opts = opts.withDefaults()
 
 		checkRejectCall := func(callExpression *ast.CallExpression) {
 			if len(callExpression.Arguments.Nodes) != 0 {
@@ -118,4 +130,4 @@ var PreferPromiseRejectErrorsRule = rule.Rule{
 			},
 		}
 	},
-}
+})
@@ -3,6 +3,7 @@ package prefer_reduce_type_parameter
 import (
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -14,8 +15,14 @@ func buildPreferTypeParameterMessage() rule.RuleMessage {
 	}
 }
 
-var PreferReduceTypeParameterRule = rule.Rule{
+var PreferReduceTypeParameterRule = registry.Register(rule.Rule{
 	Name: "prefer-reduce-type-parameter",
+	Meta: rule.RuleMeta{
+		Description: "Enforce using type parameter when calling `Array#reduce` instead of using a type assertion",
+		DocsURL:     "https://typescript-eslint.io/rules/prefer-reduce-type-parameter",
+		Category:    rule.RuleCategoryProblem,
+		Fixable:     true,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
@@ -72,4 +79,4 @@ var PreferReduceTypeParameterRule = rule.Rule{
 			},
 		}
 	},
-}
+})
@@ -3,6 +3,7 @@ package prefer_return_this_type
 import (
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 )
 
@@ -13,8 +14,14 @@ func buildUseThisTypeMessage() rule.RuleMessage {
 	}
 }
 
-var PreferReturnThisTypeRule = rule.Rule{
+var PreferReturnThisTypeRule = registry.Register(rule.Rule{
 	Name: "prefer-return-this-type",
+	Meta: rule.RuleMeta{
+		Description: "Enforce that `this` is used when only `this` type is returned",
+		DocsURL:     "https://typescript-eslint.io/rules/prefer-return-this-type",
+		Category:    rule.RuleCategorySuggestion,
+		Fixable:     true,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		var tryGetNameInTypeNode func(name string, node *ast.Node) *ast.Node
 		tryGetNameInTypeNode = func(name string, node *ast.Node) *ast.Node {
@@ -120,4 +127,4 @@ var PreferReturnThisTypeRule = rule.Rule{
 			},
 		}
 	},
-}
+})
@@ -3,6 +3,7 @@ package promise_function_async
 import (
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -24,31 +25,43 @@ type PromiseFunctionAsyncOptions struct {
 	CheckMethodDeclarations   *bool
 }
 
-var PromiseFunctionAsyncRule = rule.Rule{
+func (opts PromiseFunctionAsyncOptions) withDefaults() PromiseFunctionAsyncOptions {
+	if opts.AllowAny == nil {
+		opts.AllowAny = utils.Ref(true)
+	}
+	if opts.AllowedPromiseNames == nil {
+		opts.AllowedPromiseNames = []string{}
+	}
+	if opts.CheckArrowFunctions == nil {
+		opts.CheckArrowFunctions = utils.Ref(true)
+	}
+	if opts.CheckFunctionDeclarations == nil {
+		opts.CheckFunctionDeclarations = utils.Ref(true)
+	}
+	if opts.CheckFunctionExpressions == nil {
+		opts.CheckFunctionExpressions = utils.Ref(true)
+	}
+	if opts.CheckMethodDeclarations == nil {
+		opts.CheckMethodDeclarations = utils.Ref(true)
+	}
+	return opts
+}
+
+var PromiseFunctionAsyncRule = registry.Register(rule.Rule{
 	Name: "promise-function-async",
+	Meta: rule.RuleMeta{
+		Description:    "Require any function or method that returns a Promise to be marked async",
+		DocsURL:        "https://typescript-eslint.io/rules/promise-function-async",
+		Category:       rule.RuleCategorySuggestion,
+		Fixable:        true,
+		DefaultOptions: PromiseFunctionAsyncOptions{}.withDefaults(),
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		opts, ok := options.(PromiseFunctionAsyncOptions)
 		if !ok {
 			opts = PromiseFunctionAsyncOptions{}
 		}
-		if opts.AllowAny == nil {
-			opts.AllowAny = utils.Ref(true)
-		}
-		if opts.AllowedPromiseNames == nil {
-			opts.AllowedPromiseNames = []string{}
-		}
-		if opts.CheckArrowFunctions == nil {
-			opts.CheckArrowFunctions = utils.Ref(true)
-		}
-		if opts.CheckFunctionDeclarations == nil {
-			opts.CheckFunctionDeclarations = utils.Ref(true)
-		}
-		if opts.CheckFunctionExpressions == nil {
-			opts.CheckFunctionExpressions = utils.Ref(true)
-		}
-		if opts.CheckMethodDeclarations == nil {
-			opts.CheckMethodDeclarations = utils.Ref(true)
-		}
+		opts = opts.withDefaults()
 
 		allAllowedPromiseNames := utils.NewSetWithSizeHint[string](len(opts.AllowedPromiseNames))
 		allAllowedPromiseNames.Add("Promise")
@@ -160,4 +173,4 @@ var PromiseFunctionAsyncRule = rule.Rule{
 
 		return listeners
 	},
-}
+})
@@ -3,6 +3,7 @@ package related_getter_setter_pairs
 import (
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -14,8 +15,13 @@ func buildMismatchMessage() rule.RuleMessage {
 	}
 }
 
-var RelatedGetterSetterPairsRule = rule.Rule{
+var RelatedGetterSetterPairsRule = registry.Register(rule.Rule{
 	Name: "related-getter-setter-pairs",
+	Meta: rule.RuleMeta{
+		Description: "Enforce that `get()` types should be assignable to their equivalent `set()` type",
+		DocsURL:     "https://typescript-eslint.io/rules/related-getter-setter-pairs",
+		Category:    rule.RuleCategoryProblem,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		checkAccessorsPair := func(getter *ast.GetAccessorDeclaration, setter *ast.SetAccessorDeclaration) {
 			getType := ctx.TypeChecker.GetTypeAtLocation(getter.AsNode())
@@ -67,4 +73,4 @@ var RelatedGetterSetterPairsRule = rule.Rule{
 			ast.KindTypeLiteral:          checkMembers,
 		}
 	},
-}
+})
@@ -3,6 +3,7 @@ package require_array_sort_compare
 import (
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -18,16 +19,27 @@ type RequireArraySortCompareOptions struct {
 	IgnoreStringArrays *bool
 }
 
-var RequireArraySortCompareRule = rule.Rule{
+func (opts RequireArraySortCompareOptions) withDefaults() RequireArraySortCompareOptions {
+	if opts.IgnoreStringArrays == nil {
+		opts.IgnoreStringArrays = utils.Ref(true)
+	}
+	return opts
+}
+
+var RequireArraySortCompareRule = registry.Register(rule.Rule{
 	Name: "require-array-sort-compare",
+	Meta: rule.RuleMeta{
+		Description:    "Require `Array#sort` and `Array#toSorted` calls to always provide a `compareFunction`",
+		DocsURL:        "https://typescript-eslint.io/rules/require-array-sort-compare",
+		Category:       rule.RuleCategoryProblem,
+		DefaultOptions: RequireArraySortCompareOptions{}.withDefaults(),
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		opts, ok := options.(RequireArraySortCompareOptions)
 		if !ok {
 			opts = RequireArraySortCompareOptions{}
 		}
-		if opts.IgnoreStringArrays == nil {
-			opts.IgnoreStringArrays = utils.Ref(true)
-		}
+		opts = opts.withDefaults()
 
 		return rule.RuleListeners{
 			ast.KindCallExpression: func(node *ast.Node) {
@@ -63,4 +75,4 @@ var RequireArraySortCompareRule = rule.Rule{
 			},
 		}
 	},
-}
+})
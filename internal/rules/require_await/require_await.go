@@ -3,6 +3,7 @@ package require_await
 import (
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -27,8 +28,13 @@ type scopeInfo struct {
 	upper         *scopeInfo
 }
 
-var RequireAwaitRule = rule.Rule{
+var RequireAwaitRule = registry.Register(rule.Rule{
 	Name: "require-await",
+	Meta: rule.RuleMeta{
+		Description: "Disallow async functions which do not return promises and have no `await` expression",
+		DocsURL:     "https://typescript-eslint.io/rules/require-await",
+		Category:    rule.RuleCategorySuggestion,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		var currentScope *scopeInfo
 
@@ -277,4 +283,4 @@ var RequireAwaitRule = rule.Rule{
 			},
 		}
 	},
-}
+})
@@ -6,6 +6,7 @@ import (
 
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -38,31 +39,42 @@ type RestrictPlusOperandsOptions struct {
 	SkipCompoundAssignments *bool
 }
 
-var RestrictPlusOperandsRule = rule.Rule{
+func (opts RestrictPlusOperandsOptions) withDefaults() RestrictPlusOperandsOptions {
+	if opts.AllowAny == nil {
+		opts.AllowAny = utils.Ref(true)
+	}
+	if opts.AllowBoolean == nil {
+		opts.AllowBoolean = utils.Ref(true)
+	}
+	if opts.AllowNullish == nil {
+		opts.AllowNullish = utils.Ref(true)
+	}
+	if opts.AllowNumberAndString == nil {
+		opts.AllowNumberAndString = utils.Ref(true)
+	}
+	if opts.AllowRegExp == nil {
+		opts.AllowRegExp = utils.Ref(true)
+	}
+	if opts.SkipCompoundAssignments == nil {
+		opts.SkipCompoundAssignments = utils.Ref(false)
+	}
+	return opts
+}
+
+var RestrictPlusOperandsRule = registry.Register(rule.Rule{
 	Name: "restrict-plus-operands",
+	Meta: rule.RuleMeta{
+		Description:    "Require both operands of addition to be the same type and be `bigint`, `number`, or `string`",
+		DocsURL:        "https://typescript-eslint.io/rules/restrict-plus-operands",
+		Category:       rule.RuleCategoryProblem,
+		DefaultOptions: RestrictPlusOperandsOptions{}.withDefaults(),
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		opts, ok := options.(RestrictPlusOperandsOptions)
 		if !ok {
 			opts = RestrictPlusOperandsOptions{}
 		}
-		if opts.AllowAny == nil {
-			opts.AllowAny = utils.Ref(true)
-		}
-		if opts.AllowBoolean == nil {
-			opts.AllowBoolean = utils.Ref(true)
-		}
-		if opts.AllowNullish == nil {
-			opts.AllowNullish = utils.Ref(true)
-		}
-		if opts.AllowNumberAndString == nil {
-			opts.AllowNumberAndString = utils.Ref(true)
-		}
-		if opts.AllowRegExp == nil {
-			opts.AllowRegExp = utils.Ref(true)
-		}
-		if opts.SkipCompoundAssignments == nil {
-			opts.SkipCompoundAssignments = utils.Ref(false)
-		}
+		opts = opts.withDefaults()
 
 		stringLikes := make([]string, 0, 5)
 		if *opts.AllowAny {
@@ -198,4 +210,4 @@ var RestrictPlusOperandsRule = rule.Rule{
 			},
 		}
 	},
-}
+})
@@ -5,6 +5,7 @@ import (
 
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -28,43 +29,54 @@ type RestrictTemplateExpressionsOptions struct {
 	AllowInline  []string
 }
 
-var RestrictTemplateExpressionsRule = rule.Rule{
+func (opts RestrictTemplateExpressionsOptions) withDefaults() RestrictTemplateExpressionsOptions {
+	if opts.Allow == nil {
+		opts.Allow = []utils.TypeOrValueSpecifier{{
+			From: utils.TypeOrValueSpecifierFromLib,
+			Name: []string{"Error", "URL", "URLSearchParams"},
+		}}
+	}
+	if opts.AllowInline == nil {
+		opts.AllowInline = []string{}
+	}
+	if opts.AllowAny == nil {
+		opts.AllowAny = utils.Ref(true)
+	}
+	if opts.AllowArray == nil {
+		opts.AllowArray = utils.Ref(false)
+	}
+	if opts.AllowBoolean == nil {
+		opts.AllowBoolean = utils.Ref(true)
+	}
+	if opts.AllowNullish == nil {
+		opts.AllowNullish = utils.Ref(true)
+	}
+	if opts.AllowNumber == nil {
+		opts.AllowNumber = utils.Ref(true)
+	}
+	if opts.AllowRegExp == nil {
+		opts.AllowRegExp = utils.Ref(true)
+	}
+	if opts.AllowNever == nil {
+		opts.AllowNever = utils.Ref(false)
+	}
+	return opts
+}
+
+var RestrictTemplateExpressionsRule = registry.Register(rule.Rule{
 	Name: "restrict-template-expressions",
+	Meta: rule.RuleMeta{
+		Description:    "Enforce template literal expressions to be of `string` type",
+		DocsURL:        "https://typescript-eslint.io/rules/restrict-template-expressions",
+		Category:       rule.RuleCategoryProblem,
+		DefaultOptions: RestrictTemplateExpressionsOptions{}.withDefaults(),
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		opts, ok := options.(RestrictTemplateExpressionsOptions)
 		if !ok {
 			opts = RestrictTemplateExpressionsOptions{}
 		}
-		if opts.Allow == nil {
-			opts.Allow = []utils.TypeOrValueSpecifier{{
-				From: utils.TypeOrValueSpecifierFromLib,
-				Name: []string{"Error", "URL", "URLSearchParams"},
-			}}
-		}
-		if opts.AllowInline == nil {
-			opts.AllowInline = []string{}
-		}
-		if opts.AllowAny == nil {
-			opts.AllowAny = utils.Ref(true)
-		}
-		if opts.AllowArray == nil {
-			opts.AllowArray = utils.Ref(false)
-		}
-		if opts.AllowBoolean == nil {
-			opts.AllowBoolean = utils.Ref(true)
-		}
-		if opts.AllowNullish == nil {
-			opts.AllowNullish = utils.Ref(true)
-		}
-		if opts.AllowNumber == nil {
-			opts.AllowNumber = utils.Ref(true)
-		}
-		if opts.AllowRegExp == nil {
-			opts.AllowRegExp = utils.Ref(true)
-		}
-		if opts.AllowNever == nil {
-			opts.AllowNever = utils.Ref(false)
-		}
+		opts = opts.withDefaults()
 
 		allowedFlags := checker.TypeFlagsStringLike
 		if *opts.AllowAny {
@@ -117,4 +129,4 @@ var RestrictTemplateExpressionsRule = rule.Rule{
 			},
 		}
 	},
-}
+})
@@ -1,8 +1,13 @@
 package return_await
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -47,10 +52,80 @@ const (
 	ReturnAwaitOptionNever
 )
 
+func (o *ReturnAwaitOption) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	switch value {
+	case "always":
+		*o = ReturnAwaitOptionAlways
+	case "error-handling-correctness-only":
+		*o = ReturnAwaitOptionErrorHandlingCorrectnessOnly
+	case "in-try-catch":
+		*o = ReturnAwaitOptionInTryCatch
+	case "never":
+		*o = ReturnAwaitOptionNever
+	default:
+		return fmt.Errorf(`unknown return-await option %q, expected "always", "error-handling-correctness-only", "in-try-catch" or "never"`, value)
+	}
+	return nil
+}
+
+func (o ReturnAwaitOption) MarshalJSON() ([]byte, error) {
+	switch o {
+	case ReturnAwaitOptionAlways:
+		return json.Marshal("always")
+	case ReturnAwaitOptionErrorHandlingCorrectnessOnly:
+		return json.Marshal("error-handling-correctness-only")
+	case ReturnAwaitOptionInTryCatch:
+		return json.Marshal("in-try-catch")
+	case ReturnAwaitOptionNever:
+		return json.Marshal("never")
+	default:
+		return nil, fmt.Errorf("unexpected ReturnAwaitOption %v", uint8(o))
+	}
+}
+
 type ReturnAwaitOptions struct {
 	Option *ReturnAwaitOption
 }
 
+// UnmarshalJSON accepts both the typescript-eslint form ("in-try-catch") and
+// the object form ({"option": "in-try-catch"}).
+func (o *ReturnAwaitOptions) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '"' {
+		o.Option = new(ReturnAwaitOption)
+		return o.Option.UnmarshalJSON(trimmed)
+	}
+	type plain ReturnAwaitOptions
+	return utils.DecodeJSONStrict(data, (*plain)(o))
+}
+
+func (o ReturnAwaitOptions) JSONSchema() map[string]any {
+	option := map[string]any{
+		"enum":    []string{"always", "error-handling-correctness-only", "in-try-catch", "never"},
+		"default": *ReturnAwaitOptions{}.withDefaults().Option,
+	}
+	return map[string]any{
+		"oneOf": []any{
+			option,
+			map[string]any{
+				"type":                 "object",
+				"properties":           map[string]any{"option": option},
+				"additionalProperties": false,
+			},
+		},
+	}
+}
+
+func (opts ReturnAwaitOptions) withDefaults() ReturnAwaitOptions {
+	if opts.Option == nil {
+		opts.Option = utils.Ref(ReturnAwaitOptionInTryCatch)
+	}
+	return opts
+}
+
 type scopeInfo struct {
 	hasAsync   bool
 	owningFunc *ast.Node
@@ -94,16 +169,22 @@ func getWhetherToAwait(affectsErrorHandling bool, option ReturnAwaitOption) whet
 	}
 }
 
-var ReturnAwaitRule = rule.Rule{
+var ReturnAwaitRule = registry.Register(rule.Rule{
 	Name: "return-await",
+	Meta: rule.RuleMeta{
+		Description:    "Enforce consistent awaiting of returned promises",
+		DocsURL:        "https://typescript-eslint.io/rules/return-await",
+		Category:       rule.RuleCategoryProblem,
+		Fixable:        true,
+		HasSuggestions: true,
+		DefaultOptions: ReturnAwaitOptions{}.withDefaults(),
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		opts, ok := options.(ReturnAwaitOptions)
 		if !ok {
 			opts = ReturnAwaitOptions{}
 		}
-		if opts.Option == nil {
-			opts.Option = utils.Ref(ReturnAwaitOptionInTryCatch)
-		}
+		opts = opts.withDefaults()
 
 		var scope *scopeInfo
 
@@ -323,4 +404,4 @@ var ReturnAwaitRule = rule.Rule{
 			},
 		}
 	},
-}
+})
@@ -0,0 +1,46 @@
+// Package rules links every rule package into the binary, so that each rule
+// registers itself in the registry. New rules only need to be added here.
+package rules
+
+import (
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/await_thenable"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_array_delete"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_base_to_string"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_confusing_void_expression"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_duplicate_type_constituents"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_floating_promises"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_for_in_array"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_implied_eval"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_meaningless_void_operator"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_misused_promises"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_misused_spread"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_mixed_enums"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_redundant_type_constituents"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_unnecessary_boolean_literal_compare"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_unnecessary_template_expression"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_unnecessary_type_arguments"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_unnecessary_type_assertion"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_unsafe_argument"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_unsafe_assignment"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_unsafe_call"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_unsafe_enum_comparison"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_unsafe_member_access"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_unsafe_return"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_unsafe_type_assertion"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/no_unsafe_unary_minus"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/non_nullable_type_assertion_style"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/only_throw_error"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/prefer_promise_reject_errors"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/prefer_reduce_type_parameter"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/prefer_return_this_type"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/promise_function_async"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/related_getter_setter_pairs"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/require_array_sort_compare"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/require_await"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/restrict_plus_operands"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/restrict_template_expressions"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/return_await"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/switch_exhaustiveness_check"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/unbound_method"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules/use_unknown_in_catch_callback_variable"
+)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -36,6 +37,19 @@ type SwitchExhaustivenessCheckOptions struct {
 	RequireDefaultForNonUnion           *bool
 }
 
+func (opts SwitchExhaustivenessCheckOptions) withDefaults() SwitchExhaustivenessCheckOptions {
+	if opts.AllowDefaultCaseForExhaustiveSwitch == nil {
+		opts.AllowDefaultCaseForExhaustiveSwitch = utils.Ref(true)
+	}
+	if opts.ConsiderDefaultExhaustiveForUnions == nil {
+		opts.ConsiderDefaultExhaustiveForUnions = utils.Ref(false)
+	}
+	if opts.RequireDefaultForNonUnion == nil {
+		opts.RequireDefaultForNonUnion = utils.Ref(false)
+	}
+	return opts
+}
+
 type SwitchMetadata struct {
 	ContainsNonLiteralType bool
 	// nil if there is no default case
@@ -45,22 +59,20 @@ type SwitchMetadata struct {
 	// SymbolName string
 }
 
-var SwitchExhaustivenessCheckRule = rule.Rule{
+var SwitchExhaustivenessCheckRule = registry.Register(rule.Rule{
 	Name: "switch-exhaustiveness-check",
+	Meta: rule.RuleMeta{
+		Description:    "Require switch-case statements to be exhaustive",
+		DocsURL:        "https://typescript-eslint.io/rules/switch-exhaustiveness-check",
+		Category:       rule.RuleCategorySuggestion,
+		DefaultOptions: SwitchExhaustivenessCheckOptions{}.withDefaults(),
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		opts, ok := options.(SwitchExhaustivenessCheckOptions)
 		if !ok {
 			opts = SwitchExhaustivenessCheckOptions{}
 		}
-		if opts.AllowDefaultCaseForExhaustiveSwitch == nil {
-			opts.AllowDefaultCaseForExhaustiveSwitch = utils.Ref(true)
-		}
-		if opts.ConsiderDefaultExhaustiveForUnions == nil {
-			opts.ConsiderDefaultExhaustiveForUnions = utils.Ref(false)
-		}
-		if opts.RequireDefaultForNonUnion == nil {
-			opts.RequireDefaultForNonUnion = utils.Ref(false)
-		}
+		opts = opts.withDefaults()
 
 		isLiteralLikeType := func(t *checker.Type) bool {
 			return utils.IsTypeFlagSet(
@@ -197,4 +209,4 @@ var SwitchExhaustivenessCheckRule = rule.Rule{
 		}
 
 	},
-}
+})
@@ -3,6 +3,7 @@ package unbound_method
 import (
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -28,6 +29,13 @@ type UnboundMethodOptions struct {
 	IgnoreStatic *bool
 }
 
+func (opts UnboundMethodOptions) withDefaults() UnboundMethodOptions {
+	if opts.IgnoreStatic == nil {
+		opts.IgnoreStatic = utils.Ref(false)
+	}
+	return opts
+}
+
 func isNodeInsideTypeDeclaration(node *ast.Node) bool {
 	for parent := node.Parent; parent != nil; parent = parent.Parent {
 		switch parent.Kind {
@@ -180,16 +188,20 @@ func checkIfMethod(symbol *ast.Symbol, ignoreStatic bool) ( /* dangerous */ bool
 	return false, false
 }
 
-var UnboundMethodRule = rule.Rule{
+var UnboundMethodRule = registry.Register(rule.Rule{
 	Name: "unbound-method",
+	Meta: rule.RuleMeta{
+		Description:    "Enforce unbound methods are called with their expected scope",
+		DocsURL:        "https://typescript-eslint.io/rules/unbound-method",
+		Category:       rule.RuleCategoryProblem,
+		DefaultOptions: UnboundMethodOptions{}.withDefaults(),
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		opts, ok := options.(UnboundMethodOptions)
 		if !ok {
 			opts = UnboundMethodOptions{}
 		}
-		if opts.IgnoreStatic == nil {
-			opts.IgnoreStatic = utils.Ref(false)
-		}
+		opts = opts.withDefaults()
 
 		isNativelyBound := func(object *ast.Node, property *ast.Node) bool {
 			// We can't rely entirely on the type-level checks made at the end of this
@@ -312,4 +324,4 @@ var UnboundMethodRule = rule.Rule{
 			},
 		}
 	},
-}
+})
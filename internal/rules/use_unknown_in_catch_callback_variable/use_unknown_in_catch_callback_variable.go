@@ -5,6 +5,7 @@ import (
 
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/registry"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -56,8 +57,14 @@ func buildWrongTypeAnnotationSuggestionMessage() rule.RuleMessage {
 	}
 }
 
-var UseUnknownInCatchCallbackVariableRule = rule.Rule{
+var UseUnknownInCatchCallbackVariableRule = registry.Register(rule.Rule{
 	Name: "use-unknown-in-catch-callback-variable",
+	Meta: rule.RuleMeta{
+		Description:    "Enforce typing arguments in Promise rejection callbacks as `unknown`",
+		DocsURL:        "https://typescript-eslint.io/rules/use-unknown-in-catch-callback-variable",
+		Category:       rule.RuleCategorySuggestion,
+		HasSuggestions: true,
+	},
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		var collectFlaggedNodes func(node *ast.Node) []*ast.Node
 
@@ -220,4 +227,4 @@ var UseUnknownInCatchCallbackVariableRule = rule.Rule{
 			},
 		}
 	},
-}
+})
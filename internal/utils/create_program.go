@@ -1,7 +1,6 @@
 package utils
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/microsoft/typescript-go/shim/bundled"
@@ -17,14 +16,42 @@ func CreateCompilerHost(cwd string, fs vfs.FS) compiler.CompilerHost {
 	return compiler.NewCompilerHost(cwd, fs, defaultLibraryPath)
 }
 
-func CreateProgram(singleThreaded bool, fs vfs.FS, cwd string, tsconfigPath string, host compiler.CompilerHost) (*compiler.Program, error) {
+// ParseTSConfig reads a tsconfig along with the root file names of its
+// project and the projects it references.
+func ParseTSConfig(fs vfs.FS, cwd string, tsconfigPath string, host compiler.CompilerHost) (*tsoptions.ParsedCommandLine, error) {
 	resolvedConfigPath := tspath.ResolvePath(cwd, tsconfigPath)
 	if !fs.FileExists(resolvedConfigPath) {
 		return nil, fmt.Errorf("couldn't read tsconfig at %v", resolvedConfigPath)
 	}
 
 	configParseResult, _ := tsoptions.GetParsedCommandLineOfConfigFile(tsconfigPath, &core.CompilerOptions{}, host, nil)
+	return configParseResult, nil
+}
+
+func CreateProgram(singleThreaded bool, fs vfs.FS, cwd string, tsconfigPath string, host compiler.CompilerHost) (*compiler.Program, error) {
+	configParseResult, err := ParseTSConfig(fs, cwd, tsconfigPath, host)
+	if err != nil {
+		return nil, err
+	}
+	return CreateProgramFromConfig(singleThreaded, configParseResult, host)
+}
+
+func CreateProgramFromConfig(singleThreaded bool, configParseResult *tsoptions.ParsedCommandLine, host compiler.CompilerHost) (*compiler.Program, error) {
+	program, err := NewProgramFromConfig(singleThreaded, configParseResult, host)
+	if err != nil {
+		return nil, err
+	}
+
+	program.BindSourceFiles()
+
+	// program.CreateCheckers()
+
+	return program, nil
+}
 
+// NewProgramFromConfig is CreateProgramFromConfig without binding the source
+// files, which is needed before linting.
+func NewProgramFromConfig(singleThreaded bool, configParseResult *tsoptions.ParsedCommandLine, host compiler.CompilerHost) (*compiler.Program, error) {
 	opts := compiler.ProgramOptions{
 		Config:         configParseResult,
 		SingleThreaded: core.TSTrue,
@@ -37,15 +64,5 @@ func CreateProgram(singleThreaded bool, fs vfs.FS, cwd string, tsconfigPath stri
 	if program == nil {
 		return nil, fmt.Errorf("couldn't create program")
 	}
-
-	diagnostics := program.GetSyntacticDiagnostics(context.Background(), nil)
-	if len(diagnostics) != 0 {
-		return nil, fmt.Errorf("found %v syntactic errors. Try running \"tsgo --noEmit\" first\n", len(diagnostics))
-	}
-
-	program.BindSourceFiles()
-
-	// program.CreateCheckers()
-
 	return program, nil
 }
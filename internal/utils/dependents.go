@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/compiler"
+	"github.com/microsoft/typescript-go/shim/tspath"
+)
+
+// GetImportedFiles returns the paths of the files each file of the program
+// imports, by the path of the importing file. Unresolved imports are left out.
+func GetImportedFiles(program *compiler.Program) map[tspath.Path][]tspath.Path {
+	imports := map[tspath.Path][]tspath.Path{}
+	for importer, resolutions := range program.GetResolvedModules() {
+		for _, resolved := range resolutions {
+			if !resolved.IsResolved() {
+				continue
+			}
+			imported := program.GetSourceFileForResolvedModule(resolved.ResolvedFileName)
+			if imported == nil {
+				continue
+			}
+			imports[importer] = append(imports[importer], imported.Path())
+		}
+	}
+	return imports
+}
+
+// GetDependents returns the files of the program that import any of files,
+// directly or through other files, along with files themselves. Types flow
+// along imports, so a change in a file can change lint results of them all.
+func GetDependents(program *compiler.Program, files []*ast.SourceFile) []*ast.SourceFile {
+	importers := map[tspath.Path][]tspath.Path{}
+	for importer, imports := range GetImportedFiles(program) {
+		for _, imported := range imports {
+			importers[imported] = append(importers[imported], importer)
+		}
+	}
+
+	seen := map[tspath.Path]struct{}{}
+	queue := make([]tspath.Path, 0, len(files))
+	for _, file := range files {
+		if _, ok := seen[file.Path()]; !ok {
+			seen[file.Path()] = struct{}{}
+			queue = append(queue, file.Path())
+		}
+	}
+	for len(queue) > 0 {
+		path := queue[0]
+		queue = queue[1:]
+		for _, importer := range importers[path] {
+			if _, ok := seen[importer]; !ok {
+				seen[importer] = struct{}{}
+				queue = append(queue, importer)
+			}
+		}
+	}
+
+	var dependents []*ast.SourceFile
+	for _, file := range program.SourceFiles() {
+		if _, ok := seen[file.Path()]; ok {
+			dependents = append(dependents, file)
+		}
+	}
+	return dependents
+}
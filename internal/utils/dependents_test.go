@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/rules/fixtures"
+	"gotest.tools/v3/assert"
+)
+
+func TestGetDependents(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	fileName := func(name string) string {
+		return tspath.ResolvePath(rootDir, name)
+	}
+
+	fs := NewOverlayVFS(NewOverlayVFSForFile(fileName("file.ts"), "export const a = 1;\n"), map[string]string{
+		fileName("imports-file.ts"):      "import { a } from './file';\nexport const b = a;\n",
+		fileName("imports-importer.ts"):  "import { b } from './imports-file';\nb;\n",
+		fileName("imports-nothing.ts"):   "export const c = 1;\n",
+		fileName("imports-unrelated.ts"): "import { c } from './imports-nothing';\nc;\n",
+	})
+	program, err := CreateProgram(true, fs, rootDir, "tsconfig.json", CreateCompilerHost(rootDir, fs))
+	assert.NilError(t, err)
+
+	dependents := GetDependents(program, []*ast.SourceFile{program.GetSourceFile(fileName("file.ts"))})
+	names := Map(dependents, func(file *ast.SourceFile) string {
+		return tspath.GetBaseFileName(file.FileName())
+	})
+	assert.DeepEqual(t, names, []string{"file.ts", "imports-file.ts", "imports-importer.ts"})
+}
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Glob matches slash-separated relative paths against a pattern with the
+// syntax of minimatch, which ESLint uses:
+//   - `*` matches any characters but `/`, and `?` a single one
+//   - `**` as a whole path segment matches any number of segments
+//   - `[abc]`, `[a-z]` and `[!abc]` match a character of a class
+//   - `{a,b}` matches any of the alternatives
+type Glob struct {
+	pattern string
+	regexp  *regexp.Regexp
+}
+
+func CompileGlob(pattern string, useCaseSensitiveFileNames bool) (*Glob, error) {
+	var sb strings.Builder
+	if !useCaseSensitiveFileNames {
+		sb.WriteString("(?i)")
+	}
+	sb.WriteString("^")
+
+	p := strings.TrimPrefix(strings.TrimPrefix(pattern, "./"), "/")
+	braceDepth := 0
+	for i := 0; i < len(p); i++ {
+		ch := p[i]
+		atSegmentStart := i == 0 || p[i-1] == '/'
+		switch {
+		case ch == '*' && i+1 < len(p) && p[i+1] == '*' && atSegmentStart && (i+2 == len(p) || p[i+2] == '/'):
+			if i+2 == len(p) {
+				sb.WriteString(".*")
+			} else {
+				// "**/" may match no directory at all
+				sb.WriteString("(?:[^/]*/)*")
+			}
+			i += 2
+		case ch == '*':
+			sb.WriteString("[^/]*")
+		case ch == '?':
+			sb.WriteString("[^/]")
+		case ch == '[':
+			end := strings.IndexByte(p[i+1:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid glob %q: unterminated character class", pattern)
+			}
+			class := p[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			sb.WriteString("[" + class + "]")
+			i += end + 1
+		case ch == '{':
+			braceDepth++
+			sb.WriteString("(?:")
+		case ch == '}' && braceDepth > 0:
+			braceDepth--
+			sb.WriteString(")")
+		case ch == ',' && braceDepth > 0:
+			sb.WriteString("|")
+		case ch == '\\' && i+1 < len(p):
+			i++
+			sb.WriteString(regexp.QuoteMeta(p[i : i+1]))
+		default:
+			sb.WriteString(regexp.QuoteMeta(p[i : i+1]))
+		}
+	}
+	if braceDepth != 0 {
+		return nil, fmt.Errorf("invalid glob %q: unterminated braces", pattern)
+	}
+	sb.WriteString("$")
+
+	re, err := regexp.Compile(sb.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid glob %q: %w", pattern, err)
+	}
+	return &Glob{pattern: pattern, regexp: re}, nil
+}
+
+func (g *Glob) Match(path string) bool {
+	return g.regexp.MatchString(path)
+}
+
+func (g *Glob) String() string {
+	return g.pattern
+}
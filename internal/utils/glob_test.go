@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestGlob(t *testing.T) {
+	cases := []struct {
+		pattern string
+		path    string
+		matches bool
+	}{
+		{"**/*.test.ts", "a.test.ts", true},
+		{"**/*.test.ts", "src/deep/a.test.ts", true},
+		{"**/*.test.ts", "src/a.ts", false},
+		{"*.ts", "a.ts", true},
+		{"*.ts", "src/a.ts", false},
+		{"scripts/**", "scripts/build.ts", true},
+		{"scripts/**", "scripts/tools/build.ts", true},
+		{"scripts/**", "src/scripts/build.ts", false},
+		{"./src/*.ts", "src/a.ts", true},
+		{"src/**/index.ts", "src/index.ts", true},
+		{"src/**/index.ts", "src/a/b/index.ts", true},
+		{"src/?.ts", "src/a.ts", true},
+		{"src/?.ts", "src/ab.ts", false},
+		{"**/*.{ts,tsx}", "src/a.tsx", true},
+		{"**/*.{ts,tsx}", "src/a.js", false},
+		{"src/[ab].ts", "src/b.ts", true},
+		{"src/[!ab].ts", "src/b.ts", false},
+		{"a.ts", "a.ts", true},
+		{"a.ts", "axts", false},
+	}
+	for _, c := range cases {
+		t.Run(c.pattern+" "+c.path, func(t *testing.T) {
+			glob, err := CompileGlob(c.pattern, true)
+			assert.NilError(t, err)
+			assert.Equal(t, glob.Match(c.path), c.matches)
+		})
+	}
+
+	glob, err := CompileGlob("SRC/*.ts", false)
+	assert.NilError(t, err)
+	assert.Assert(t, glob.Match("src/a.ts"))
+
+	_, err = CompileGlob("src/{a,b", true)
+	assert.ErrorContains(t, err, "unterminated braces")
+}
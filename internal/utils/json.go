@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// DecodeJSONStrict decodes data into v, rejecting unknown object keys and
+// trailing data. Option types with custom UnmarshalJSON methods should use it
+// too, since the strictness of an outer decoder is not propagated to them.
+func DecodeJSONStrict(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+	if decoder.More() {
+		return fmt.Errorf("unexpected data after JSON value")
+	}
+	return nil
+}
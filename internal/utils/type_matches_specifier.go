@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"encoding/json"
 	"fmt"
 	"slices"
 	"strings"
@@ -28,6 +29,99 @@ type TypeOrValueSpecifier struct {
 	Package string
 }
 
+// UnmarshalJSON accepts the typescript-eslint object form of a specifier,
+// e.g. {"from": "package", "name": ["Foo", "Bar"], "package": "foo"}.
+func (s *TypeOrValueSpecifier) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		From    string
+		Name    json.RawMessage
+		Path    string
+		Package string
+	}
+	if err := DecodeJSONStrict(data, &raw); err != nil {
+		return err
+	}
+
+	switch raw.From {
+	case "file":
+		s.From = TypeOrValueSpecifierFromFile
+	case "lib":
+		s.From = TypeOrValueSpecifierFromLib
+	case "package":
+		s.From = TypeOrValueSpecifierFromPackage
+		if raw.Package == "" {
+			return fmt.Errorf(`specifier with "from": "package" requires a "package" name`)
+		}
+	default:
+		return fmt.Errorf(`unknown specifier source %q, expected "file", "lib" or "package"`, raw.From)
+	}
+	if raw.Path != "" && s.From != TypeOrValueSpecifierFromFile {
+		return fmt.Errorf(`"path" can only be used with "from": "file"`)
+	}
+	if raw.Package != "" && s.From != TypeOrValueSpecifierFromPackage {
+		return fmt.Errorf(`"package" can only be used with "from": "package"`)
+	}
+
+	var name string
+	if err := json.Unmarshal(raw.Name, &name); err == nil {
+		s.Name = []string{name}
+	} else if err := json.Unmarshal(raw.Name, &s.Name); err != nil || len(s.Name) == 0 {
+		return fmt.Errorf(`specifier "name" must be a string or a non-empty array of strings`)
+	}
+	s.Path = raw.Path
+	s.Package = raw.Package
+
+	return nil
+}
+
+func (s TypeOrValueSpecifier) MarshalJSON() ([]byte, error) {
+	raw := map[string]any{"name": s.Name}
+	switch s.From {
+	case TypeOrValueSpecifierFromFile:
+		raw["from"] = "file"
+		if s.Path != "" {
+			raw["path"] = s.Path
+		}
+	case TypeOrValueSpecifierFromLib:
+		raw["from"] = "lib"
+	case TypeOrValueSpecifierFromPackage:
+		raw["from"] = "package"
+		raw["package"] = s.Package
+	}
+	return json.Marshal(raw)
+}
+
+func (s TypeOrValueSpecifier) JSONSchema() map[string]any {
+	name := map[string]any{
+		"oneOf": []any{
+			map[string]any{"type": "string"},
+			map[string]any{"type": "array", "items": map[string]any{"type": "string"}, "minItems": 1},
+		},
+	}
+	return map[string]any{
+		"oneOf": []any{
+			map[string]any{
+				"type":                 "object",
+				"properties":           map[string]any{"from": map[string]any{"const": "file"}, "name": name, "path": map[string]any{"type": "string"}},
+				"required":             []string{"from", "name"},
+				"additionalProperties": false,
+			},
+			map[string]any{
+				"type":                 "object",
+				"properties":           map[string]any{"from": map[string]any{"const": "lib"}, "name": name},
+				"required":             []string{"from", "name"},
+				"additionalProperties": false,
+			},
+			map[string]any{
+				"type":                 "object",
+				"properties":           map[string]any{"from": map[string]any{"const": "package"}, "name": name, "package": map[string]any{"type": "string"}},
+				"required":             []string{"from", "name", "package"},
+				"additionalProperties": false,
+			},
+		},
+	}
+}
+
 func typeMatchesStringSpecifier(
 	t *checker.Type,
 	names []string,
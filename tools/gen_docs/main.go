@@ -0,0 +1,142 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/typescript-eslint/tsgolint/internal/registry"
+	_ "github.com/typescript-eslint/tsgolint/internal/rules"
+)
+
+const (
+	rulesTableStart = "<!-- begin rules table -->\n"
+	rulesTableEnd   = "<!-- end rules table -->\n"
+)
+
+// Regenerates the rules table in README.md and the JSON schema of tsgolint.json
+// from the rule registry. Run from the repo root.
+func main() {
+	readme, err := os.ReadFile("README.md")
+	if err != nil {
+		log.Fatalf("Error reading README.md: %v", err)
+	}
+	readme, err = replaceRulesTable(readme)
+	if err != nil {
+		log.Fatalf("Error updating README.md: %v", err)
+	}
+	if err := os.WriteFile("README.md", readme, 0o644); err != nil {
+		log.Fatalf("Error writing README.md: %v", err)
+	}
+
+	schema, err := json.MarshalIndent(configSchema(), "", "  ")
+	if err != nil {
+		log.Fatalf("Error encoding schema: %v", err)
+	}
+	if err := os.WriteFile("tsgolint.schema.json", append(schema, '\n'), 0o644); err != nil {
+		log.Fatalf("Error writing tsgolint.schema.json: %v", err)
+	}
+}
+
+func replaceRulesTable(readme []byte) ([]byte, error) {
+	start := bytes.Index(readme, []byte(rulesTableStart))
+	end := bytes.Index(readme, []byte(rulesTableEnd))
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("couldn't find rules table markers")
+	}
+
+	var table strings.Builder
+	table.WriteString(rulesTableStart)
+	table.WriteString("\n| Name | Description | 🔧 | 💡 |\n| ---- | ----------- | -- | -- |\n")
+	for _, r := range registry.All() {
+		fixable, hasSuggestions := "", ""
+		if r.Meta.Fixable {
+			fixable = "🔧"
+		}
+		if r.Meta.HasSuggestions {
+			hasSuggestions = "💡"
+		}
+		fmt.Fprintf(&table, "| [%v](%v) | %v | %v | %v |\n", r.Name, r.Meta.DocsURL, r.Meta.Description, fixable, hasSuggestions)
+	}
+	table.WriteString("\n🔧 fixable with `--fix`, 💡 provides suggestions\n\n")
+
+	result := bytes.Clone(readme[:start])
+	result = append(result, table.String()...)
+	return append(result, readme[end:]...), nil
+}
+
+// configSchema describes tsgolint.json. The rules and their options are
+// defined once under $defs, since both the top level and the overrides
+// configure rules.
+func configSchema() map[string]any {
+	defs := map[string]any{
+		"severity": map[string]any{"enum": []any{"error", "warn", "off", 2, 1, 0, true, false}},
+	}
+	severity := map[string]any{"$ref": "#/$defs/severity"}
+	rules := map[string]any{}
+	for _, r := range registry.All() {
+		variants := []any{severity}
+		if schema := registry.OptionsSchema(r); schema != nil {
+			optionsName := r.Name + "-options"
+			defs[optionsName] = schema
+			options := map[string]any{"$ref": "#/$defs/" + optionsName}
+			// like the config loader, only objects of options are accepted
+			// without a severity, since other options look like severities
+			bareOptions := map[string]any{"type": "object", "$ref": "#/$defs/" + optionsName}
+			variants = append(variants, bareOptions, map[string]any{
+				"type":        "array",
+				"prefixItems": []any{severity, options},
+				"minItems":    1,
+				"maxItems":    2,
+			})
+		} else {
+			variants = append(variants, map[string]any{
+				"type":        "array",
+				"prefixItems": []any{severity},
+				"minItems":    1,
+				"maxItems":    1,
+			})
+		}
+		rules[r.Name] = map[string]any{
+			"description": r.Meta.Description + "\n" + r.Meta.DocsURL,
+			"oneOf":       variants,
+		}
+	}
+	defs["rules"] = map[string]any{
+		"type":                 "object",
+		"properties":           rules,
+		"additionalProperties": false,
+	}
+	rulesSchema := map[string]any{"$ref": "#/$defs/rules"}
+	return map[string]any{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title":   "tsgolint config",
+		"type":    "object",
+		"$defs":   defs,
+		"properties": map[string]any{
+			"$schema": map[string]any{"type": "string"},
+			"rules":   rulesSchema,
+			"overrides": map[string]any{
+				"description": "Rule configs for files matching globs, relative to the config file. Later overrides win over earlier ones.",
+				"type":        "array",
+				"items": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"files": map[string]any{
+							"type":     "array",
+							"items":    map[string]any{"type": "string"},
+							"minItems": 1,
+						},
+						"rules": rulesSchema,
+					},
+					"required":             []any{"files"},
+					"additionalProperties": false,
+				},
+			},
+		},
+		"additionalProperties": false,
+	}
+}